@@ -1,25 +1,52 @@
 package meteocat
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 	"time"
+	"unicode/utf16"
 	"unicode/utf8"
 
 	"github.com/luisfrmoro/meteocat/endpoint"
 	"github.com/luisfrmoro/meteocat/model"
 )
 
+// ErrUnsupportedCharset is matched (via errors.Is) by errors caused by a response
+// declaring a charset the client cannot decode.
+var ErrUnsupportedCharset = model.ErrUnsupportedCharset
+
+// ErrInvalidResult is matched (via errors.Is) by errors caused by a WithResultValidator callback
+// rejecting a successfully decoded response.
+var ErrInvalidResult = model.ErrInvalidResult
+
+// ErrEmptyReference is matched (via errors.Is) by errors caused by WithNonEmptyReferenceCheck
+// rejecting an empty reference list.
+var ErrEmptyReference = model.ErrEmptyReference
+
+// ErrUnauthorized is matched (via errors.Is) by Ping errors caused by the API rejecting the API key.
+var ErrUnauthorized = model.ErrUnauthorized
+
+// ErrUnreachable is matched (via errors.Is) by Ping errors caused by the API not answering at all.
+var ErrUnreachable = model.ErrUnreachable
+
+// Version is the version of this library, reported in the default User-Agent header.
+const Version = "0.1.0"
+
 const (
 	baseURL           = "https://api.meteo.cat"
-	userAgent         = "meteocat-go/0.1.0"
+	userAgent         = "meteocat-go/" + Version
 	contentTypeHeader = "Content-Type"
+	apiKeyHeader      = "x-api-key"
 )
 
 // Client manages requests to the METEOCAT HTTP API.
@@ -31,20 +58,54 @@ const (
 type Client struct {
 	baseURL         string
 	httpClient      *http.Client
+	baseHTTPClient  *http.Client
 	userAgent       string
 	maxResponseBody int64
 	apiKey          string `json:"-"`
+	apiKeyHeader    string
+	apiKeyScheme    string
+	responseCapture ResponseCaptureFunc
+	responseSize    ResponseSizeFunc
+	etagCache       *etagCache
+	requestTimeout  time.Duration
+	retry           retryPolicy
+	flights         *flightGroup
+	slots           chan struct{}
+	clientName      string
+	language        string
+	rawStationCodes bool
+	sortResults     bool
+	transport       http.RoundTripper
+	proxyURL        *url.URL
+	pool            *connectionPool
+
+	maxDecompressedBody int64
+	strictKeyValidation bool
+	strictUTF8          bool
+	resultValidator     ResultValidatorFunc
+	beforeRequest       BeforeRequestFunc
+	requestMetrics      RequestMetricsFunc
+	rawResponses        bool
+	nonEmptyReference   bool
+	compressionDisabled bool
+	normMunicipalities  bool
+	transportChanged    bool
+	insecureSkipVerify  bool
 }
 
 // String implements fmt.Stringer but intentionally omits the API key.
 func (c *Client) String() string {
+	if c.clientName != "" {
+		return fmt.Sprintf("meteocat.Client{Name:%s, BaseURL:%s, APIKeySet:%t}", c.clientName, c.baseURL, c.apiKey != "")
+	}
 	return fmt.Sprintf("meteocat.Client{BaseURL:%s, APIKeySet:%t}", c.baseURL, c.apiKey != "")
 }
 
 // NewClient constructs a new *Client using the provided API key.
 // If httpClient is nil, a sensible default with a 10s timeout is used.
 // The apiKey must be a valid METEOCAT API key; it will be used in the Authorization header for all requests.
-func NewClient(apiKey string, httpClient *http.Client) (*Client, error) {
+// Optional behavior can be configured with ClientOption values, applied in the given order.
+func NewClient(apiKey string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if apiKey == "" {
 		return nil, fmt.Errorf("api key is required")
 	}
@@ -53,13 +114,79 @@ func NewClient(apiKey string, httpClient *http.Client) (*Client, error) {
 		httpClient = &http.Client{Timeout: 10 * time.Second}
 	}
 
-	return &Client{
+	c := &Client{
 		baseURL:         baseURL,
 		httpClient:      httpClient,
+		baseHTTPClient:  httpClient,
 		userAgent:       userAgent,
 		maxResponseBody: 10 << 20, // 10 MB
 		apiKey:          apiKey,
-	}, nil
+		apiKeyHeader:    apiKeyHeader,
+		retry:           defaultRetryPolicy(),
+	}
+
+	if err := c.apply(opts); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Clone returns a new client with the receiver's configuration and the given options applied on top,
+// e.g. a different timeout or User-Agent suffix for one call site. The receiver is left untouched and
+// both clients are safe to use concurrently. The clone shares the receiver's ETag cache, request
+// deduplication, concurrency limit and HTTP transport (with its idle connections) unless the options
+// replace them; options changing transport settings give the clone its own transport.
+func (c *Client) Clone(opts ...ClientOption) (*Client, error) {
+	clone := *c
+	if err := clone.apply(opts); err != nil {
+		return nil, err
+	}
+	return &clone, nil
+}
+
+// apply runs opts in order, skipping nil ones, and then derives the settings that depend on them.
+func (c *Client) apply(opts []ClientOption) error {
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if err := opt(c); err != nil {
+			return err
+		}
+	}
+
+	if c.strictKeyValidation {
+		if err := validateAPIKeyFormat(c.apiKey); err != nil {
+			return err
+		}
+	}
+
+	if c.maxDecompressedBody == 0 {
+		c.maxDecompressedBody = decompressedBodyFactor * c.maxResponseBody
+	}
+
+	if c.insecureSkipVerify {
+		if err := c.checkInsecureSkipVerify(); err != nil {
+			return err
+		}
+	}
+
+	if c.transportChanged {
+		if err := c.applyTransport(); err != nil {
+			return err
+		}
+		c.transportChanged = false
+	}
+	return nil
+}
+
+// userAgentHeader returns the User-Agent value, with the client name appended when one is set.
+func (c *Client) userAgentHeader() string {
+	if c.clientName != "" {
+		return c.userAgent + " " + c.clientName
+	}
+	return c.userAgent
 }
 
 // isJSONContent returns true if the content type indicates JSON or a JSON-based media type.
@@ -81,7 +208,8 @@ func validateHTTPOut(out any) *model.APIError {
 }
 
 // prepareRequest creates a new HTTP request with the given context, method, and URL,
-// applying standard headers (Accept, User-Agent, Authorization).
+// applying standard headers (Accept, User-Agent, the API key header and, when configured or carried
+// by the context, Accept-Language and X-Correlation-ID).
 func (c *Client) prepareRequest(ctx context.Context, method, url string) (*http.Request, *model.APIError) {
 	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
@@ -89,22 +217,49 @@ func (c *Client) prepareRequest(ctx context.Context, method, url string) (*http.
 	}
 
 	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", c.userAgent)
-	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("User-Agent", c.userAgentHeader())
+	if c.language != "" {
+		req.Header.Set("Accept-Language", c.language)
+	}
+	if id := correlationID(ctx); id != "" {
+		req.Header.Set(correlationIDHeader, id)
+	}
+	apiKey := c.apiKey
+	if override := apiKeyOverride(ctx); override != "" {
+		apiKey = override
+	}
+	if c.apiKeyScheme != "" {
+		req.Header.Set(c.apiKeyHeader, c.apiKeyScheme+" "+apiKey)
+	} else {
+		req.Header.Set(c.apiKeyHeader, apiKey)
+	}
 
 	return req, nil
 }
 
 // readResponseBody reads the response body with a size limit to prevent OOM attacks.
-func (c *Client) readResponseBody(resp *http.Response) ([]byte, *model.APIError) {
-	limitedReader := io.LimitReader(resp.Body, c.maxResponseBody+1)
-	respBytes, err := io.ReadAll(limitedReader)
+// Compressed bodies are decoded first; the received bytes are limited to maxResponseBody and the
+// decompressed bytes to maxDecompressedBody. The decoded size is reported to the response size callback,
+// if any, including for oversized bodies, for which the exceeded limit is reported.
+func (c *Client) readResponseBody(resp *http.Response, resource string) ([]byte, *model.APIError) {
+	body, err := c.decodedBody(resp)
 	if err != nil {
+		if apiErr := c.bodyTooLargeError(resp, resource, err); apiErr != nil {
+			return nil, apiErr
+		}
+		return nil, &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("decode response: %v", err)}
+	}
+
+	respBytes, err := io.ReadAll(body)
+	if err != nil {
+		if apiErr := c.bodyTooLargeError(resp, resource, err); apiErr != nil {
+			return nil, apiErr
+		}
 		return nil, &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("read response: %v", err)}
 	}
 
-	if int64(len(respBytes)) > c.maxResponseBody {
-		return nil, &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("response body too large: limit %d bytes", c.maxResponseBody)}
+	if c.responseSize != nil {
+		c.responseSize(resource, len(respBytes))
 	}
 
 	return respBytes, nil
@@ -137,6 +292,8 @@ func (c *Client) handleSuccessResponse(resp *http.Response, respBytes []byte, ou
 }
 
 // handleErrorResponse parses an API error response, attempting to extract structured error information.
+// Bodies that are neither JSON nor declared as JSON, such as gateway error pages, yield a concise message
+// (see nonJSONErrorMessage); the full body remains available through WithResponseCapture.
 func (c *Client) handleErrorResponse(resp *http.Response, respBytes []byte) *model.APIError {
 	var apiErr model.APIError
 	if len(respBytes) == 0 {
@@ -144,7 +301,14 @@ func (c *Client) handleErrorResponse(resp *http.Response, respBytes []byte) *mod
 	}
 
 	if err := json.Unmarshal(respBytes, &apiErr); err != nil || (apiErr.Message == "" && apiErr.Code == 0) {
-		return &model.APIError{Code: resp.StatusCode, Message: string(respBytes)}
+		if !isJSONContent(resp.Header.Get(contentTypeHeader)) {
+			return &model.APIError{Code: resp.StatusCode, Message: nonJSONErrorMessage(resp.StatusCode, respBytes)}
+		}
+		message := errorBodyPreview(respBytes)
+		if message == "" {
+			message = http.StatusText(resp.StatusCode)
+		}
+		return &model.APIError{Code: resp.StatusCode, Message: message}
 	}
 
 	if apiErr.Code == 0 {
@@ -154,23 +318,58 @@ func (c *Client) handleErrorResponse(resp *http.Response, respBytes []byte) *mod
 	return &apiErr
 }
 
-func (c *Client) readAndNormalizeJSON(resp *http.Response) ([]byte, *model.APIError) {
-	respBytes, apiErr := c.readResponseBody(resp)
+func (c *Client) readAndNormalizeJSON(resp *http.Response, resource string) ([]byte, *model.APIError) {
+	respBytes, apiErr := c.readResponseBody(resp, resource)
 	if apiErr != nil {
 		return nil, apiErr
 	}
 
+	if c.strictUTF8 {
+		return strictUTF8Bytes(resp.StatusCode, respBytes)
+	}
 	return normalizeJSONBytes(resp.Header.Get(contentTypeHeader), respBytes)
 }
 
+// strictUTF8Bytes strips a leading UTF-8 byte-order mark and fails on invalid UTF-8 instead of transcoding.
+// It is used in place of normalizeJSONBytes when WithStrictUTF8 is enabled.
+func strictUTF8Bytes(status int, respBytes []byte) ([]byte, *model.APIError) {
+	respBytes = bytes.TrimPrefix(respBytes, utf8BOM)
+	if !utf8.Valid(respBytes) {
+		offset := 0
+		for offset < len(respBytes) {
+			r, size := utf8.DecodeRune(respBytes[offset:])
+			if r == utf8.RuneError && size <= 1 {
+				break
+			}
+			offset += size
+		}
+		return nil, &model.APIError{Code: status, Message: fmt.Sprintf("invalid UTF-8 in response body at byte %d", offset)}
+	}
+	return respBytes, nil
+}
+
 // normalizeJSONBytes ensures JSON payloads are decoded as UTF-8 before unmarshalling.
 // It converts from common legacy encodings (ISO-8859-1, Windows-1252) when detected
 // via Content-Type or when the payload contains invalid UTF-8.
+// A leading byte-order mark takes precedence over the declared charset: a UTF-8 BOM is stripped
+// and UTF-16 (LE or BE) payloads are converted to UTF-8.
 func normalizeJSONBytes(contentType string, respBytes []byte) ([]byte, *model.APIError) {
 	if len(respBytes) == 0 {
 		return respBytes, nil
 	}
 
+	switch {
+	case bytes.HasPrefix(respBytes, utf8BOM):
+		respBytes = respBytes[len(utf8BOM):]
+		if utf8.Valid(respBytes) {
+			return respBytes, nil
+		}
+	case bytes.HasPrefix(respBytes, utf16LEBOM):
+		return utf16ToUTF8(respBytes[len(utf16LEBOM):], binary.LittleEndian)
+	case bytes.HasPrefix(respBytes, utf16BEBOM):
+		return utf16ToUTF8(respBytes[len(utf16BEBOM):], binary.BigEndian)
+	}
+
 	charset := ""
 	if contentType != "" {
 		_, params, err := mime.ParseMediaType(contentType)
@@ -195,10 +394,33 @@ func normalizeJSONBytes(contentType string, respBytes []byte) ([]byte, *model.AP
 		if utf8.Valid(respBytes) {
 			return respBytes, nil
 		}
-		return nil, &model.APIError{Message: fmt.Sprintf("unsupported charset %q in content-type %q", charset, contentType)}
+		return nil, &model.APIError{
+			Message: fmt.Sprintf("unsupported charset %q in content-type %q", charset, contentType),
+			Err:     &model.CharsetError{Charset: charset},
+		}
 	}
 }
 
+// Byte-order marks recognized at the start of a response body.
+var (
+	utf8BOM    = []byte{0xEF, 0xBB, 0xBF}
+	utf16LEBOM = []byte{0xFF, 0xFE}
+	utf16BEBOM = []byte{0xFE, 0xFF}
+)
+
+// utf16ToUTF8 converts UTF-16 input in the given byte order to UTF-8.
+func utf16ToUTF8(input []byte, order binary.ByteOrder) ([]byte, *model.APIError) {
+	if len(input)%2 != 0 {
+		return nil, &model.APIError{Message: "invalid UTF-16 response body: odd length"}
+	}
+
+	units := make([]uint16, len(input)/2)
+	for i := range units {
+		units[i] = order.Uint16(input[2*i:])
+	}
+	return []byte(string(utf16.Decode(units))), nil
+}
+
 func latin1ToUTF8(input []byte) []byte {
 	output := make([]byte, 0, len(input)*2)
 	for _, b := range input {
@@ -280,42 +502,183 @@ func windows1252ToUTF8(input []byte) []byte {
 //
 // Returns *APIError on any failure (HTTP errors, parsing errors, network errors, etc.)
 func (c *Client) do(ctx context.Context, method, resource string, out any) *model.APIError {
+	_, apiErr := c.doWithMeta(ctx, method, resource, out)
+	return apiErr
+}
+
+// doWithMeta behaves like do but also reports response metadata such as the HTTP status
+// and whether the API answered with no content. The metadata is zero when no response was received.
+// Every returned error is annotated with the request method and resource.
+func (c *Client) doWithMeta(ctx context.Context, method, resource string, out any) (model.Meta, *model.APIError) {
+	start := time.Now()
+	meta, apiErr := c.doWithRetry(ctx, method, resource, out)
+	if apiErr == nil && c.resultValidator != nil {
+		if err := c.resultValidator(resource, out); err != nil {
+			apiErr = &model.APIError{
+				Code:    meta.StatusCode,
+				Message: fmt.Sprintf("invalid result: %v", err),
+				Err:     fmt.Errorf("%w: %w", ErrInvalidResult, err),
+			}
+		}
+	}
+	if apiErr == nil && c.nonEmptyReference && emptyReferenceList(resource, out) {
+		apiErr = &model.APIError{
+			Code:    meta.StatusCode,
+			Message: "invalid result: empty reference list",
+			Err:     fmt.Errorf("%w: %w", ErrInvalidResult, ErrEmptyReference),
+		}
+	}
+	apiErr = withRequest(apiErr, method, resource)
+	c.recordRequest(method, resource, start, meta.StatusCode, apiErr)
+	return meta, apiErr
+}
+
+// doWithRetry validates out, fails fast on a done context, applies the default timeout and runs attempts
+// according to the retry policy.
+func (c *Client) doWithRetry(ctx context.Context, method, resource string, out any) (model.Meta, *model.APIError) {
 	if err := validateHTTPOut(out); err != nil {
-		return err
+		return model.Meta{}, err
+	}
+
+	// Do not set up a request for a context that is already done.
+	if err := ctx.Err(); err != nil {
+		return model.Meta{}, &model.APIError{Message: fmt.Sprintf("request to METEOCAT API: %v", err), Err: err}
+	}
+
+	if c.requestTimeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+			defer cancel()
+		}
 	}
 
+	for attempt := 0; ; attempt++ {
+		meta, apiErr, retryable := c.attempt(ctx, method, resource, out)
+		if apiErr == nil || !retryable || attempt+1 >= c.retry.maxAttempts {
+			return meta, apiErr
+		}
+		if !c.retry.wait(ctx, attempt) {
+			return meta, apiErr
+		}
+	}
+}
+
+// withRequest records the request method and resource on apiErr, unless already set.
+func withRequest(apiErr *model.APIError, method, resource string) *model.APIError {
+	if apiErr == nil {
+		return nil
+	}
+	if apiErr.Method == "" && apiErr.Path == "" {
+		apiErr.Method = method
+		apiErr.Path = resource
+	}
+	return apiErr
+}
+
+// attempt performs a single HTTP request and decodes the response into out.
+// The retryable result reports whether the failure may succeed on a new attempt
+// according to the client's retry policy.
+func (c *Client) attempt(ctx context.Context, method, resource string, out any) (model.Meta, *model.APIError, bool) {
+	var meta model.Meta
+
+	var res fetchResult
+	if c.flights != nil && method == http.MethodGet {
+		// Requests authenticated with different keys must not share a response.
+		key := method + " " + resource + "\x00" + apiKeyOverride(ctx)
+		res = c.flights.do(ctx, key, func(ctx context.Context) fetchResult {
+			return c.fetch(ctx, method, resource)
+		})
+	} else {
+		res = c.fetch(ctx, method, resource)
+	}
+	if res.apiErr != nil {
+		apiErr := *res.apiErr
+		return meta, &apiErr, res.retryable
+	}
+	resp, respBytes := res.resp, res.body
+
+	meta.StatusCode = resp.StatusCode
+
+	// Serve the cached result when the resource has not changed
+	if cached, handled, apiErr := c.handleNotModified(ctx, resp, resource, out); handled {
+		if apiErr == nil && c.captureRaw(ctx) {
+			meta.Raw = bytes.Clone(cached)
+		}
+		return meta, apiErr, false
+	}
+
+	// Handle response status
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return meta, c.handleErrorResponse(resp, respBytes), c.retry.retryableStatus(resp.StatusCode)
+	}
+
+	meta.NoContent = resp.StatusCode == http.StatusNoContent && len(respBytes) == 0
+
+	// Unmarshal response directly into out
+	if apiErr := c.handleSuccessResponse(resp, respBytes, out); apiErr != nil {
+		return meta, apiErr, false
+	}
+	c.storeETag(ctx, resp, resource, respBytes)
+	if c.captureRaw(ctx) {
+		// The body may be shared with deduplicated callers and the ETag cache.
+		meta.Raw = bytes.Clone(respBytes)
+	}
+
+	return meta, nil, false
+}
+
+// fetchResult is the outcome of a single HTTP exchange: the response (with its body already
+// consumed and closed) and its normalized body, or the error that prevented reading it.
+type fetchResult struct {
+	resp      *http.Response
+	body      []byte
+	apiErr    *model.APIError
+	retryable bool
+}
+
+// fetch sends a single request for resource and reads its normalized body.
+// The response status is not interpreted here so the result can be shared between callers.
+func (c *Client) fetch(ctx context.Context, method, resource string) fetchResult {
+	release, apiErr := c.acquireSlot(ctx)
+	if apiErr != nil {
+		return fetchResult{apiErr: apiErr}
+	}
+	defer release()
+
 	// Request to METEOCAT API endpoint
 	url := c.baseURL + "/" + strings.TrimLeft(resource, "/")
 	req, apiErr := c.prepareRequest(ctx, method, url)
 	if apiErr != nil {
-		return apiErr
+		return fetchResult{apiErr: apiErr}
+	}
+	c.applyETag(req, resource)
+	if apiErr := c.runBeforeRequest(req); apiErr != nil {
+		return fetchResult{apiErr: apiErr}
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return &model.APIError{Message: fmt.Sprintf("request to METEOCAT API: %v", err)}
+		return fetchResult{
+			apiErr:    &model.APIError{Message: fmt.Sprintf("request to METEOCAT API: %v", err), Err: err},
+			retryable: ctx.Err() == nil,
+		}
 	}
 	defer func() {
 		io.Copy(io.Discard, resp.Body)
 		resp.Body.Close()
 	}()
 
-	respBytes, apiErr := c.readAndNormalizeJSON(resp)
+	respBytes, apiErr := c.readAndNormalizeJSON(resp, resource)
 	if apiErr != nil {
-		return apiErr
+		return fetchResult{apiErr: apiErr}
 	}
 
-	// Handle response status
-	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
-		return c.handleErrorResponse(resp, respBytes)
+	if c.responseCapture != nil {
+		c.responseCapture(resource, resp.StatusCode, respBytes)
 	}
 
-	// Unmarshal response directly into out
-	if apiErr := c.handleSuccessResponse(resp, respBytes, out); apiErr != nil {
-		return apiErr
-	}
-
-	return nil
+	return fetchResult{resp: resp, body: respBytes}
 }
 
 // Regions fetches the list of all regional administrative divisions from the METEOCAT API.
@@ -344,7 +707,86 @@ func (c *Client) do(ctx context.Context, method, resource string, out any) *mode
 //		fmt.Printf("%d: %s\n", r.Code, r.Name)
 //	}
 func (c *Client) Regions(ctx context.Context) (model.RegionList, *model.APIError) {
-	return endpoint.Regions(ctx, c.do)
+	regions, apiErr := endpoint.Regions(ctx, c.do)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return sortByCode(c, regions, func(r model.Region) int { return r.Code }), nil
+}
+
+// Region fetches a single region (comarca) by its numeric code.
+// The METEOCAT API does not expose a per-region resource, so this fetches the full list
+// from /referencia/v1/comarques and returns the matching entry. WithETagCache avoids
+// re-downloading an unchanged list on repeated calls.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - code: the region code (e.g., 13 for Barcelonès)
+//
+// Returns:
+//   - *model.Region: the region with the given code
+//   - *model.APIError: error if the request fails, or a 404 error if no region has that code
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	region, err := client.Region(context.Background(), 13)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Println(region.Name)
+func (c *Client) Region(ctx context.Context, code int) (*model.Region, *model.APIError) {
+	regions, apiErr := c.Regions(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	if region, ok := regions.ByCode(code); ok {
+		return region, nil
+	}
+	return nil, &model.APIError{Code: http.StatusNotFound, Message: fmt.Sprintf("region %d not found", code)}
+}
+
+// Ping verifies that the API is reachable and accepts the client's API key, e.g. before starting a
+// batch job. It requests the small regions list and discards it. Failures are classified so callers
+// can react appropriately: a rejected key (401 or 403) matches ErrUnauthorized with errors.Is, and a
+// request that got no response (DNS, connection or TLS failure) matches ErrUnreachable. Other
+// failures, such as 5xx responses, are returned unchanged.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//
+// Returns:
+//   - *APIError: nil when the API answered successfully
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	if err := client.Ping(context.Background()); err != nil {
+//		if errors.Is(err, meteocat.ErrUnauthorized) {
+//			log.Fatal("check METEOCAT_API_KEY")
+//		}
+//		log.Fatal(err)
+//	}
+func (c *Client) Ping(ctx context.Context) *model.APIError {
+	var regions model.RegionList
+	apiErr := c.do(ctx, http.MethodGet, "/referencia/v1/comarques", &regions)
+	if apiErr == nil {
+		return nil
+	}
+
+	// Transport failures keep their cause alongside ErrUnreachable; HTTP failures carry none.
+	var urlErr *url.Error
+	switch {
+	case apiErr.Code == 0 && ctx.Err() == nil && errors.As(apiErr.Err, &urlErr):
+		apiErr.Err = fmt.Errorf("%w: %w", ErrUnreachable, apiErr.Err)
+	case apiErr.Err != nil:
+	case apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden:
+		apiErr.Err = ErrUnauthorized
+	case apiErr.Code == 0 && ctx.Err() == nil:
+		apiErr.Err = ErrUnreachable
+	}
+	return apiErr
 }
 
 // Municipalities fetches the list of all municipalities from the METEOCAT API.
@@ -382,7 +824,11 @@ func (c *Client) Regions(ctx context.Context) (model.RegionList, *model.APIError
 //		fmt.Printf("  Coordinates: %.4f°N, %.4f°E\n", m.Coordinates.Latitude, m.Coordinates.Longitude)
 //	}
 func (c *Client) Municipalities(ctx context.Context) (model.MunicipalityList, *model.APIError) {
-	return endpoint.Municipalities(ctx, c.do)
+	municipalities, apiErr := endpoint.Municipalities(ctx, c.do)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return sortByCode(c, municipalities, func(m model.Municipality) string { return m.Code }), nil
 }
 
 // Symbols fetches the complete catalog of meteorological symbols from the METEOCAT API.
@@ -443,6 +889,12 @@ func WithStationDate(date time.Time) StationMetadataOption {
 	return endpoint.WithStationDate(date)
 }
 
+// WithStationNetwork keeps only stations belonging to the network with the given code.
+// The API has no network query parameter, so the filter is applied client-side to the response.
+func WithStationNetwork(code int) StationMetadataOption {
+	return endpoint.WithStationNetwork(code)
+}
+
 // Stations fetches the list of XEMA station metadata from the METEOCAT API.
 // The endpoint can optionally filter results by operational status and date.
 //
@@ -451,6 +903,7 @@ func WithStationDate(date time.Time) StationMetadataOption {
 // To request all stations without filtering, call Stations with no options.
 // To filter by status on a specific date, you must provide both
 // WithStationStatus and WithStationDate options.
+// WithStationNetwork can be combined with either form and is applied client-side.
 //
 // Parameters:
 //   - ctx: context for cancellation and timeouts
@@ -487,7 +940,11 @@ func WithStationDate(date time.Time) StationMetadataOption {
 //		fmt.Printf("%s: %s\n", s.Code, s.Name)
 //	}
 func (c *Client) Stations(ctx context.Context, opts ...StationMetadataOption) (model.StationList, *model.APIError) {
-	return endpoint.Stations(ctx, c.do, opts...)
+	stations, apiErr := endpoint.Stations(ctx, c.do, opts...)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return sortByCode(c, stations, func(s model.Station) string { return s.Code }), nil
 }
 
 // Variable type alias for metadata of a single XEMA variable.
@@ -508,6 +965,16 @@ type StationObservation = model.StationObservation
 // StationObservationList type alias for a collection of observations.
 type StationObservationList = model.StationObservationList
 
+// ObservationOption configures optional filters for station observation requests.
+type ObservationOption = endpoint.ObservationOption
+
+// WithTimeBase keeps only the readings measured with the given time base (e.g., model.TimeBaseHourly).
+// The METEOCAT API cannot filter by time base, so the filter is applied client-side to the response;
+// variables left without readings are dropped.
+func WithTimeBase(tb model.TimeBase) ObservationOption {
+	return endpoint.WithTimeBase(tb)
+}
+
 // Observations fetches all observations of all variables recorded at a station for a specific day.
 // The endpoint returns observation measurements grouped by variable, with each variable containing
 // a list of readings taken throughout the day.
@@ -516,6 +983,7 @@ type StationObservationList = model.StationObservationList
 //   - ctx: context for cancellation and timeouts
 //   - stationCode: the unique identifier of the station (e.g., "CC")
 //   - date: the specific date for which observations are requested
+//   - opts: optional client-side filters (e.g., WithTimeBase)
 //
 // Returns:
 //   - StationObservationList: list of observations with all variables and readings
@@ -535,8 +1003,141 @@ type StationObservationList = model.StationObservationList
 //			fmt.Printf("  Variable %d: %d readings\n", varObs.Code, len(varObs.Readings))
 //		}
 //	}
-func (c *Client) Observations(ctx context.Context, stationCode string, date time.Time) (StationObservationList, *model.APIError) {
-	return endpoint.Observations(ctx, c.do, stationCode, date)
+func (c *Client) Observations(ctx context.Context, stationCode string, date time.Time, opts ...ObservationOption) (StationObservationList, *model.APIError) {
+	return endpoint.Observations(ctx, c.do, c.stationCode(stationCode), date, opts...)
+}
+
+// ValidatedObservations fetches the quality-controlled observations of all variables recorded at a station
+// for a specific day. Observations returns data as measured; this product only contains readings that passed
+// METEOCAT's validation, so prefer it for analysis. Recent days may be incomplete until validation catches up.
+// The result has the same structure as Observations.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which observations are requested
+//   - opts: optional client-side filters (e.g., WithTimeBase)
+//
+// Returns:
+//   - StationObservationList: list of validated observations with all variables and readings
+//   - *APIError: error if the request fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	date := time.Date(2020, time.June, 16, 0, 0, 0, 0, time.UTC)
+//	obs, err := client.ValidatedObservations(context.Background(), "CC", date)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, stationObs := range obs {
+//		fmt.Printf("Station %s: %d validated variables\n", stationObs.Code, len(stationObs.Variables))
+//	}
+func (c *Client) ValidatedObservations(ctx context.Context, stationCode string, date time.Time, opts ...ObservationOption) (StationObservationList, *model.APIError) {
+	return endpoint.ValidatedObservations(ctx, c.do, c.stationCode(stationCode), date, opts...)
+}
+
+// ObservationsForVariables fetches the observations of specific variables recorded at a station for a specific day.
+// The METEOCAT API has no multi-variable query: one request per distinct variable code is issued concurrently
+// against /xema/v1/variables/mesurades/{variable}/{YYYY}/{MM}/{DD}?codiEstacio={code}, and the results are merged
+// into a single StationObservationList. A single variable code results in a single request.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - variableCodes: the numeric codes of the variables to fetch (see Variables)
+//   - date: the specific date for which observations are requested
+//
+// Returns:
+//   - StationObservationList: merged observations of the requested variables
+//   - *APIError: error if variableCodes is empty, any of the requests fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	date := time.Date(2020, time.June, 16, 0, 0, 0, 0, time.UTC)
+//	obs, err := client.ObservationsForVariables(context.Background(), "CC", []int{32, 33, 30}, date)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) ObservationsForVariables(ctx context.Context, stationCode string, variableCodes []int, date time.Time) (StationObservationList, *model.APIError) {
+	return endpoint.ObservationsForVariables(ctx, c.do, c.stationCode(stationCode), variableCodes, date)
+}
+
+// Meta type alias for response metadata reported alongside decoded data.
+type Meta = model.Meta
+
+// ObservationsWithMeta behaves like Observations but also returns response metadata.
+// Meta.NoContent is true when the API answered 204 No Content, which lets data pipelines
+// distinguish "no observations published" from an empty list in the body.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which observations are requested
+//
+// Returns:
+//   - StationObservationList: list of observations with all variables and readings
+//   - Meta: HTTP status and no-content signal of the response
+//   - *APIError: error if the request fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	date := time.Date(2020, time.June, 16, 0, 0, 0, 0, time.UTC)
+//	obs, meta, err := client.ObservationsWithMeta(context.Background(), "CC", date)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if meta.NoContent {
+//		fmt.Println("no observations published for this day")
+//	}
+func (c *Client) ObservationsWithMeta(ctx context.Context, stationCode string, date time.Time) (StationObservationList, Meta, *model.APIError) {
+	var meta Meta
+	do := func(ctx context.Context, method, resource string, out any) *model.APIError {
+		var apiErr *model.APIError
+		meta, apiErr = c.doWithMeta(ctx, method, resource, out)
+		return apiErr
+	}
+
+	list, apiErr := endpoint.Observations(ctx, do, c.stationCode(stationCode), date)
+	return list, meta, apiErr
+}
+
+// ObservationsRaw behaves like Observations but also returns the response body exactly as it was
+// unmarshaled: the bytes sent by the API after charset normalization to UTF-8. This is meant for
+// debugging and auditing. The client must be created with WithRawResponseAccess.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which observations are requested
+//
+// Returns:
+//   - StationObservationList: list of observations with all variables and readings
+//   - json.RawMessage: the normalized response body
+//   - *APIError: error if raw access is disabled, the request fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil, meteocat.WithRawResponseAccess())
+//	date := time.Date(2020, time.June, 16, 0, 0, 0, 0, time.UTC)
+//	obs, raw, err := client.ObservationsRaw(context.Background(), "CC", date)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	os.WriteFile("CC-2020-06-16.json", raw, 0o644)
+func (c *Client) ObservationsRaw(ctx context.Context, stationCode string, date time.Time) (StationObservationList, json.RawMessage, *model.APIError) {
+	do, raw, apiErr := c.rawDo()
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	list, apiErr := endpoint.Observations(ctx, do, c.stationCode(stationCode), date)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+	return list, *raw, nil
 }
 
 // Variables fetches the metadata of all XEMA variables.
@@ -562,7 +1163,81 @@ func (c *Client) Observations(ctx context.Context, stationCode string, date time
 //		fmt.Printf("%d: %s (%s) - %d decimals\n", v.Code, v.Name, v.Unit, v.Decimals)
 //	}
 func (c *Client) Variables(ctx context.Context) (VariableList, *model.APIError) {
-	return endpoint.Variables(ctx, c.do)
+	variables, apiErr := endpoint.Variables(ctx, c.do)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return sortByCode(c, variables, func(v model.Variable) int { return v.Code }), nil
+}
+
+// VariableStation type alias for a station measuring a variable, with its state windows.
+type VariableStation = model.VariableStation
+
+// VariableStationList type alias for the stations measuring a variable.
+type VariableStationList = model.VariableStationList
+
+// VariableStations fetches the stations that measure the variable identified by variableCode,
+// with the periods during which each station measured it.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - variableCode: the numeric code of the variable (e.g., 32 for temperature)
+//
+// Returns:
+//   - VariableStationList: stations measuring the variable with their state windows
+//   - *APIError: error if the request fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	stations, err := client.VariableStations(context.Background(), 32)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, s := range stations {
+//		fmt.Printf("%s: %d state(s)\n", s.Code, len(s.States))
+//	}
+func (c *Client) VariableStations(ctx context.Context, variableCode int) (VariableStationList, *model.APIError) {
+	return endpoint.VariableStations(ctx, c.do, variableCode)
+}
+
+// StationDailyStats type alias for the daily statistical summary of a station.
+type StationDailyStats = model.StationDailyStats
+
+// DailyVariableStats type alias for the daily aggregates of a single variable.
+type DailyVariableStats = model.DailyVariableStats
+
+// DailyExtreme type alias for a daily extreme value with the time it occurred.
+type DailyExtreme = model.DailyExtreme
+
+// DailyStats fetches the daily statistical summary (resum) of all variables measured at a station for a specific day.
+// Instead of raw readings, each variable carries its daily maximum and minimum (with the time they were reached),
+// its mean and its accumulated value; aggregates that do not apply to a variable are nil.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which the summary is requested
+//
+// Returns:
+//   - StationDailyStats: per-variable daily aggregates for the station
+//   - *APIError: error if the request fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	date := time.Date(2020, time.June, 16, 0, 0, 0, 0, time.UTC)
+//	stats, err := client.DailyStats(context.Background(), "CC", date)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, v := range stats.Variables {
+//		if v.Max != nil {
+//			fmt.Printf("Variable %d: max %.1f at %s\n", v.Code, v.Max.Value, v.Max.Time.Format("15:04"))
+//		}
+//	}
+func (c *Client) DailyStats(ctx context.Context, stationCode string, date time.Time) (StationDailyStats, *model.APIError) {
+	return endpoint.DailyStats(ctx, c.do, c.stationCode(stationCode), date)
 }
 
 // MunicipalityHourlyForecast type alias for a complete 72-hour hourly forecast for a municipality.
@@ -644,5 +1319,373 @@ type ForecastDay = model.ForecastDay
 //		}
 //	}
 func (c *Client) MunicipalHourlyForecast(ctx context.Context, municipalityCode string) (MunicipalityHourlyForecast, *model.APIError) {
-	return endpoint.MunicipalHourlyForecast(ctx, c.do, municipalityCode)
+	return endpoint.MunicipalHourlyForecast(ctx, c.do, c.municipalityCode(municipalityCode))
+}
+
+// MunicipalHourlyForecasts fetches 72-hour hourly forecasts for several municipalities concurrently.
+// At most concurrency requests are in flight at any time; values below 1 are treated as 1.
+//
+// Successful forecasts and per-code errors are returned in separate maps keyed by municipality code
+// (normalized with WithMunicipalityCodePadding), so a single unknown or failing municipality does not
+// discard the rest of the batch.
+// If ctx is cancelled, codes that were not requested yet are reported in the error map.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - codes: municipality codes to fetch (e.g., "250019", "080193")
+//   - concurrency: maximum number of concurrent requests
+//
+// Returns:
+//   - map[string]model.MunicipalityHourlyForecast: forecasts for the codes that succeeded
+//   - map[string]*model.APIError: errors for the codes that failed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	forecasts, errs := client.MunicipalHourlyForecasts(context.Background(), []string{"250019", "080193"}, 4)
+//	for code, err := range errs {
+//		log.Printf("forecast %s: %v", code, err)
+//	}
+//	for code, forecast := range forecasts {
+//		fmt.Printf("%s: %d days\n", code, len(forecast.Days))
+//	}
+func (c *Client) MunicipalHourlyForecasts(ctx context.Context, codes []string, concurrency int) (map[string]model.MunicipalityHourlyForecast, map[string]*model.APIError) {
+	if c.normMunicipalities {
+		normalized := make([]string, len(codes))
+		for i, code := range codes {
+			normalized[i] = c.municipalityCode(code)
+		}
+		codes = normalized
+	}
+	return endpoint.MunicipalHourlyForecasts(ctx, c.do, codes, concurrency)
+}
+
+// PlaceWeather type alias for a municipality forecast combined with station observations.
+type PlaceWeather = model.PlaceWeather
+
+// PlaceWeather fetches the 72-hour hourly forecast of a municipality and the observations of a station
+// for a day concurrently, e.g. to show current conditions next to the upcoming forecast.
+// Each part reports its own error, so the forecast is still returned when the station has no
+// observations for the day (and vice versa); the returned error is only set when both fail.
+// Cancelling ctx aborts both requests.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "080193")
+//   - stationCode: the unique identifier of the station (e.g., "X4")
+//   - obsDate: the day for which observations are requested
+//
+// Returns:
+//   - PlaceWeather: the forecast and observations, with ForecastErr and ObservationsErr for partial failures
+//   - *model.APIError: the forecast error if both requests fail
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	place, err := client.PlaceWeather(context.Background(), "080193", "X4", time.Now())
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	if place.ObservationsErr != nil {
+//		log.Printf("observations unavailable: %v", place.ObservationsErr)
+//	}
+//	fmt.Printf("%d forecast days, %d stations observed\n", len(place.Forecast.Days), len(place.Observations))
+func (c *Client) PlaceWeather(ctx context.Context, municipalityCode, stationCode string, obsDate time.Time) (PlaceWeather, *model.APIError) {
+	return endpoint.PlaceWeather(ctx, c.do, c.municipalityCode(municipalityCode), c.stationCode(stationCode), obsDate)
+}
+
+// SolarEnrichedForecast type alias for a municipal forecast enriched with sunrise/sunset information.
+type SolarEnrichedForecast = model.SolarEnrichedForecast
+
+// SolarForecastDay type alias for a forecast day with its solar event times.
+type SolarForecastDay = model.SolarForecastDay
+
+// SolarSkyValue type alias for an hourly sky condition value tagged as day or night.
+type SolarSkyValue = model.SolarSkyValue
+
+// MunicipalHourlyForecastWithSolar fetches the 72-hour hourly forecast for a municipality and enriches it
+// with sunrise and sunset times computed for coord (NOAA solar equations, no network access).
+// Each hourly sky condition value is tagged as day or night, so callers can pick the matching
+// day or night icon for the symbol code.
+//
+// The forecast payload does not include the municipality's location; coordinates can be
+// obtained from the municipalities metadata endpoint (Municipalities method).
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "080193")
+//   - coord: location of the municipality used for the solar calculations
+//
+// Returns:
+//   - SolarEnrichedForecast: forecast days with sunrise, sunset and day/night tagged sky values
+//   - *APIError: error if coord is invalid, the request fails, or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	forecast, err := client.MunicipalHourlyForecastWithSolar(context.Background(), "080193",
+//		model.Coordinates{Latitude: 41.3874, Longitude: 2.1686})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, day := range forecast.Days {
+//		fmt.Printf("%s: sunrise %s, sunset %s\n", day.Date, day.Sunrise.Format("15:04"), day.Sunset.Format("15:04"))
+//		for _, sky := range day.Sky {
+//			fmt.Printf("  %s symbol %s (day=%v)\n", sky.Time.Format("15:04"), sky.Value, sky.IsDay)
+//		}
+//	}
+func (c *Client) MunicipalHourlyForecastWithSolar(ctx context.Context, municipalityCode string, coord model.Coordinates) (SolarEnrichedForecast, *model.APIError) {
+	if !coord.Valid() || coord.IsZero() {
+		return SolarEnrichedForecast{}, &model.APIError{Message: "valid coordinates are required for solar enrichment"}
+	}
+
+	forecast, apiErr := endpoint.MunicipalHourlyForecast(ctx, c.do, c.municipalityCode(municipalityCode))
+	if apiErr != nil {
+		return SolarEnrichedForecast{}, apiErr
+	}
+	return forecast.WithSolar(coord), nil
+}
+
+// ForecastNearest fetches the 72-hour hourly forecast of the municipality closest to coord.
+// METEOCAT does not offer a point (latitude/longitude) forecast, so this resolves the nearest
+// municipality center from the municipalities metadata endpoint (see MunicipalityList.Nearest)
+// and fetches its forecast; the chosen municipality is reported in MunicipalityCode.
+// This takes two requests; WithETagCache avoids re-downloading an unchanged municipality list.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - coord: the location of interest (e.g., a station's coordinates)
+//
+// Returns:
+//   - MunicipalityHourlyForecast: the forecast of the nearest municipality
+//   - *APIError: error if coord is invalid, a request fails, or no municipality has coordinates (404)
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	forecast, err := client.ForecastNearest(context.Background(),
+//		model.Coordinates{Latitude: 41.3874, Longitude: 2.1686})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	fmt.Printf("forecast for municipality %s\n", forecast.MunicipalityCode)
+func (c *Client) ForecastNearest(ctx context.Context, coord model.Coordinates) (MunicipalityHourlyForecast, *model.APIError) {
+	if !coord.Valid() || coord.IsZero() {
+		return MunicipalityHourlyForecast{}, &model.APIError{Message: "valid coordinates are required to find the nearest municipality"}
+	}
+
+	municipalities, apiErr := c.Municipalities(ctx)
+	if apiErr != nil {
+		return MunicipalityHourlyForecast{}, apiErr
+	}
+
+	nearest, ok := municipalities.Nearest(coord)
+	if !ok {
+		return MunicipalityHourlyForecast{}, &model.APIError{Code: http.StatusNotFound, Message: "no municipality with coordinates found"}
+	}
+	return c.MunicipalHourlyForecast(ctx, nearest.Code)
+}
+
+// ForecastIconURLs returns the distinct sky state icon URLs that the municipality's hourly forecast
+// will display, e.g. to prefetch or cache the images. It fetches the forecast and the symbol catalog,
+// resolves every code from ReferencedSymbolCodes in the "cel" category and collects both the day
+// and the night icon of each symbol. URLs are deduplicated and keep the order of first appearance;
+// codes missing from the catalog and empty icon URLs are skipped.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "080193")
+//
+// Returns:
+//   - []string: distinct icon URLs
+//   - *APIError: error if a request fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	urls, err := client.ForecastIconURLs(context.Background(), "080193")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, u := range urls {
+//		fmt.Println(u)
+//	}
+func (c *Client) ForecastIconURLs(ctx context.Context, municipalityCode string) ([]string, *model.APIError) {
+	forecast, apiErr := c.MunicipalHourlyForecast(ctx, municipalityCode)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	codes := forecast.ReferencedSymbolCodes()
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	symbols, apiErr := c.Symbols(ctx)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	index := symbols.Index()
+	var urls []string
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		symbol, ok := index.Resolve("cel", model.StringOrFloat64(code))
+		if !ok {
+			continue
+		}
+		for _, u := range []string{symbol.IconURL, symbol.IconURLNight} {
+			if u != "" && !seen[u] {
+				seen[u] = true
+				urls = append(urls, u)
+			}
+		}
+	}
+	return urls, nil
+}
+
+// CoastalForecast type alias for the maritime forecast of the Catalan coast.
+type CoastalForecast = model.CoastalForecast
+
+// CoastalZoneForecast type alias for the forecast periods of a single coastal zone.
+type CoastalZoneForecast = model.CoastalZoneForecast
+
+// CoastalPeriod type alias for the maritime conditions forecast during a validity period.
+type CoastalPeriod = model.CoastalPeriod
+
+// SeaState type alias for a Douglas sea scale degree.
+type SeaState = model.SeaState
+
+// CoastalForecast fetches the maritime forecast for the Catalan coast.
+// The forecast is split into coastal zones; each zone lists validity periods with
+// the expected sea state (Douglas scale), significant wave height and wind over water.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//
+// Returns:
+//   - CoastalForecast: maritime forecast for every coastal zone
+//   - *APIError: error if the request fails or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	forecast, err := client.CoastalForecast(context.Background())
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, zone := range forecast.Zones {
+//		for _, p := range zone.Periods {
+//			fmt.Printf("%s %s: %s, waves %.1f-%.1f m\n", zone.Name, p.ValidFrom.Format("15:04"), p.SeaState, p.WaveHeightMin, p.WaveHeightMax)
+//		}
+//	}
+func (c *Client) CoastalForecast(ctx context.Context) (CoastalForecast, *model.APIError) {
+	return endpoint.CoastalForecast(ctx, c.do)
+}
+
+// RegionalForecast type alias for the textual forecast of a region (comarca).
+type RegionalForecast = model.RegionalForecast
+
+// RegionalForecastPeriod type alias for the forecast of one segment of a day in a region.
+type RegionalForecastPeriod = model.RegionalForecastPeriod
+
+// RegionalForecast fetches the textual forecast for a region (comarca).
+// This coarser product complements the municipal forecasts: it covers a validity window split into
+// morning, afternoon and night periods, each with a sky symbol code and a temperature trend.
+//
+// The region code must be obtained from the regions metadata endpoint (Regions method).
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - regionCode: the numeric identifier of the region (e.g., 13 for Barcelonès)
+//
+// Returns:
+//   - RegionalForecast: forecast periods for the region
+//   - *APIError: error if the request fails, region code is invalid, or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	forecast, err := client.RegionalForecast(context.Background(), 13)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, p := range forecast.Periods {
+//		fmt.Printf("%s %s: %s\n", p.Date, p.Segment, p.Description)
+//	}
+func (c *Client) RegionalForecast(ctx context.Context, regionCode int) (RegionalForecast, *model.APIError) {
+	return endpoint.RegionalForecast(ctx, c.do, regionCode)
+}
+
+// UVIndexForecast type alias for the UV index forecast of a municipality.
+type UVIndexForecast = model.UVIndexForecast
+
+// UVIndexDay type alias for the UV index forecast of a single day.
+type UVIndexDay = model.UVIndexDay
+
+// UVCategory type alias for a UV index risk category.
+type UVCategory = model.UVCategory
+
+// UVIndexForecast fetches the UV index forecast for a municipality.
+// Each day carries the maximum expected UV index and its risk category
+// (low, moderate, high, very high or extreme), derived with model.UVRisk.
+//
+// The municipality code must be obtained from the municipalities metadata endpoint (Municipalities method).
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "080193")
+//
+// Returns:
+//   - UVIndexForecast: daily maximum UV index values with risk categories
+//   - *APIError: error if the request fails, municipality code is invalid, or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	forecast, err := client.UVIndexForecast(context.Background(), "080193")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, day := range forecast.Days {
+//		fmt.Printf("%s: UV %.1f (%s)\n", day.Date, day.MaxIndex, day.Risk)
+//	}
+func (c *Client) UVIndexForecast(ctx context.Context, municipalityCode string) (UVIndexForecast, *model.APIError) {
+	return endpoint.UVIndexForecast(ctx, c.do, c.municipalityCode(municipalityCode))
+}
+
+// MunicipalityExtendedForecast type alias for the daily forecast of a municipality beyond 72 hours.
+type MunicipalityExtendedForecast = model.MunicipalityExtendedForecast
+
+// ExtendedForecastDay type alias for a single day of an extended municipal forecast.
+type ExtendedForecastDay = model.ExtendedForecastDay
+
+// MunicipalExtendedForecast fetches the daily forecast of a municipality for the coming days (typically 8),
+// for planning beyond the 72-hour horizon of MunicipalHourlyForecast. Each day carries the minimum and
+// maximum temperatures, the probability of precipitation and the sky state symbol code.
+//
+// The municipality code must be obtained from the municipalities metadata endpoint (Municipalities method).
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "080193")
+//
+// Returns:
+//   - MunicipalityExtendedForecast: daily forecast values for each day
+//   - *APIError: error if the request fails, municipality code is invalid, or data cannot be parsed
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	forecast, err := client.MunicipalExtendedForecast(context.Background(), "080193")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	for _, day := range forecast.Days {
+//		if min, max, ok := day.TemperatureRange(); ok {
+//			fmt.Printf("%s: %.1f to %.1f °C\n", day.Date, min, max)
+//		}
+//	}
+func (c *Client) MunicipalExtendedForecast(ctx context.Context, municipalityCode string) (MunicipalityExtendedForecast, *model.APIError) {
+	return endpoint.MunicipalExtendedForecast(ctx, c.do, c.municipalityCode(municipalityCode))
 }
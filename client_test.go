@@ -0,0 +1,1124 @@
+package meteocat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const testAPIKey = "test-api-key"
+
+// roundTripFunc adapts a function into an http.RoundTripper for tests.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newTestResponse builds an HTTP response with the given status, content type and body.
+func newTestResponse(req *http.Request, status int, contentType, body string) *http.Response {
+	header := make(http.Header)
+	if contentType != "" {
+		header.Set(contentTypeHeader, contentType)
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+// newTestClient creates a Client whose transport is served by fn.
+func newTestClient(t *testing.T, fn roundTripFunc, opts ...ClientOption) *Client {
+	t.Helper()
+
+	client, err := NewClient(testAPIKey, &http.Client{Transport: fn}, opts...)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	return client
+}
+
+// TestWithResponseCapture verifies that the capture callback receives the raw body
+// for both successful and error responses.
+func TestWithResponseCapture(t *testing.T) {
+	type captured struct {
+		path   string
+		status int
+		body   string
+	}
+	var calls []captured
+
+	capture := func(path string, status int, body []byte) {
+		calls = append(calls, captured{path: path, status: status, body: string(body)})
+	}
+
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/comarques") {
+			return newTestResponse(req, http.StatusOK, "application/json", `[{"codi":"x","nom":1}]`), nil
+		}
+		return newTestResponse(req, http.StatusNotFound, "application/json", `{"message":"Not found"}`), nil
+	}, WithResponseCapture(capture))
+
+	ctx := context.Background()
+	if _, apiErr := client.Regions(ctx); apiErr == nil {
+		t.Fatal("expected unmarshal error for mismatched payload, got nil")
+	}
+	if _, apiErr := client.Municipalities(ctx); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 error, got %v", apiErr)
+	}
+
+	expected := []captured{
+		{path: "/referencia/v1/comarques", status: http.StatusOK, body: `[{"codi":"x","nom":1}]`},
+		{path: "/referencia/v1/municipis", status: http.StatusNotFound, body: `{"message":"Not found"}`},
+	}
+	if len(calls) != len(expected) {
+		t.Fatalf("expected %d captures, got %d", len(expected), len(calls))
+	}
+	for i, want := range expected {
+		if calls[i] != want {
+			t.Errorf("capture %d: expected %+v, got %+v", i, want, calls[i])
+		}
+	}
+}
+
+// TestNewClient_OptionError verifies that an option error aborts client construction.
+func TestNewClient_OptionError(t *testing.T) {
+	failing := func(c *Client) error {
+		return errors.New("invalid option")
+	}
+
+	client, err := NewClient(testAPIKey, nil, failing)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if client != nil {
+		t.Errorf("expected nil client, got %v", client)
+	}
+}
+
+// TestWithAPIKeyHeader verifies the API key header for the default and a Bearer configuration.
+func TestWithAPIKeyHeader(t *testing.T) {
+	testCases := []struct {
+		name   string
+		opts   []ClientOption
+		header string
+		value  string
+	}{
+		{"default", nil, "x-api-key", testAPIKey},
+		{"bearer", []ClientOption{WithAPIKeyHeader("Authorization", "Bearer")}, "Authorization", "Bearer " + testAPIKey},
+		{"custom header", []ClientOption{WithAPIKeyHeader("X-Meteocat-Key", "")}, "X-Meteocat-Key", testAPIKey},
+	}
+
+	for _, tc := range testCases {
+		var got *http.Request
+		client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+			got = req
+			return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+		}, tc.opts...)
+
+		if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, apiErr)
+		}
+		if value := got.Header.Get(tc.header); value != tc.value {
+			t.Errorf("%s: expected %s header %q, got %q", tc.name, tc.header, tc.value, value)
+		}
+		if tc.header != "x-api-key" && got.Header.Get("x-api-key") != "" {
+			t.Errorf("%s: expected no x-api-key header, got %q", tc.name, got.Header.Get("x-api-key"))
+		}
+	}
+}
+
+// TestWithAPIKeyHeader_InvalidName verifies that an empty header name is rejected.
+func TestWithAPIKeyHeader_InvalidName(t *testing.T) {
+	for _, name := range []string{"", "  ", "Bad Header"} {
+		if _, err := NewClient(testAPIKey, nil, WithAPIKeyHeader(name, "Bearer")); err == nil {
+			t.Errorf("expected error for header name %q, got nil", name)
+		}
+	}
+}
+
+// TestObservationsWithMeta verifies that a 204 response is reported as NoContent
+// while an empty JSON list is not.
+func TestObservationsWithMeta(t *testing.T) {
+	date := time.Date(2020, time.June, 16, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		status    int
+		body      string
+		noContent bool
+	}{
+		{"no content", http.StatusNoContent, "", true},
+		{"empty list", http.StatusOK, "[]", false},
+	}
+
+	for _, tc := range testCases {
+		client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path != "/xema/v1/estacions/mesurades/CC/2020/06/16" {
+				t.Errorf("%s: unexpected path %s", tc.name, req.URL.Path)
+			}
+			return newTestResponse(req, tc.status, "application/json", tc.body), nil
+		})
+
+		obs, meta, apiErr := client.ObservationsWithMeta(context.Background(), "CC", date)
+		if apiErr != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, apiErr)
+		}
+		if meta.StatusCode != tc.status {
+			t.Errorf("%s: expected status %d, got %d", tc.name, tc.status, meta.StatusCode)
+		}
+		if meta.NoContent != tc.noContent {
+			t.Errorf("%s: expected NoContent %t, got %t", tc.name, tc.noContent, meta.NoContent)
+		}
+		if len(obs) != 0 {
+			t.Errorf("%s: expected no observations, got %d", tc.name, len(obs))
+		}
+	}
+}
+
+// TestObservationsWithMeta_Error verifies that the status is reported for error responses.
+func TestObservationsWithMeta_Error(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusNotFound, "application/json", `{"message":"Station not found"}`), nil
+	})
+
+	_, meta, apiErr := client.ObservationsWithMeta(context.Background(), "XX", time.Now())
+	if apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 error, got %v", apiErr)
+	}
+	if meta.StatusCode != http.StatusNotFound || meta.NoContent {
+		t.Errorf("unexpected meta %+v", meta)
+	}
+}
+
+// TestWithDefaultRequestTimeout verifies that contexts without a deadline receive the default
+// timeout while a caller deadline is preserved.
+func TestWithDefaultRequestTimeout(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		deadline, hasDeadline = req.Context().Deadline()
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithDefaultRequestTimeout(time.Minute))
+
+	start := time.Now()
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	end := time.Now()
+	if !hasDeadline {
+		t.Fatal("expected default deadline on a context without one")
+	}
+	if deadline.Before(start.Add(time.Minute)) || deadline.After(end.Add(time.Minute)) {
+		t.Errorf("expected deadline one minute after the request, got %v", deadline.Sub(start))
+	}
+
+	callerDeadline := time.Now().Add(time.Hour)
+	ctx, cancel := context.WithDeadline(context.Background(), callerDeadline)
+	defer cancel()
+	if _, apiErr := client.Regions(ctx); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if !deadline.Equal(callerDeadline) {
+		t.Errorf("expected caller deadline %v to be preserved, got %v", callerDeadline, deadline)
+	}
+
+	tight := time.Now().Add(10 * time.Second)
+	ctx, cancel = context.WithDeadline(context.Background(), tight)
+	defer cancel()
+	if _, apiErr := client.Regions(ctx); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if !deadline.Equal(tight) {
+		t.Errorf("expected tighter caller deadline %v to be preserved, got %v", tight, deadline)
+	}
+}
+
+// TestWithDefaultRequestTimeout_Invalid verifies that non-positive timeouts are rejected.
+func TestWithDefaultRequestTimeout_Invalid(t *testing.T) {
+	if _, err := NewClient(testAPIKey, nil, WithDefaultRequestTimeout(0)); err == nil {
+		t.Error("expected error for zero timeout, got nil")
+	}
+}
+
+// TestNormalizeJSONBytes_UnsupportedCharset verifies that an unknown charset yields an error
+// matching ErrUnsupportedCharset and carrying the charset name.
+func TestNormalizeJSONBytes_UnsupportedCharset(t *testing.T) {
+	_, apiErr := normalizeJSONBytes("application/json; charset=koi8-r", []byte{'[', 0xC1, ']'})
+	if apiErr == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.Is(apiErr, ErrUnsupportedCharset) {
+		t.Errorf("expected error to match ErrUnsupportedCharset, got %v", apiErr)
+	}
+
+	var charsetErr *model.CharsetError
+	if !errors.As(apiErr, &charsetErr) {
+		t.Fatalf("expected *model.CharsetError cause, got %T", apiErr.Err)
+	}
+	if charsetErr.Charset != "koi8-r" {
+		t.Errorf("expected charset koi8-r, got %s", charsetErr.Charset)
+	}
+
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json; charset=koi8-r", "[\xC1]"), nil
+	})
+	if _, apiErr := client.Regions(context.Background()); !errors.Is(apiErr, ErrUnsupportedCharset) {
+		t.Errorf("expected client error to match ErrUnsupportedCharset, got %v", apiErr)
+	}
+}
+
+// TestAPIError_Unwrap verifies that errors without a cause do not match the sentinel.
+func TestAPIError_Unwrap(t *testing.T) {
+	apiErr := &model.APIError{Code: 404, Message: "Not found"}
+	if errors.Is(apiErr, ErrUnsupportedCharset) {
+		t.Error("expected plain APIError not to match ErrUnsupportedCharset")
+	}
+}
+
+// TestWithUserAgentSuffix verifies the composed User-Agent header and last-writer-wins
+// composition with WithUserAgent.
+func TestWithUserAgentSuffix(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     []ClientOption
+		expected string
+	}{
+		{"default", nil, "meteocat-go/" + Version},
+		{"suffix", []ClientOption{WithUserAgentSuffix("weatherboard/2.1")}, "meteocat-go/" + Version + " weatherboard/2.1"},
+		{"override", []ClientOption{WithUserAgent("custom/1.0")}, "custom/1.0"},
+		{"override then suffix", []ClientOption{WithUserAgent("custom/1.0"), WithUserAgentSuffix("weatherboard/2.1")}, "meteocat-go/" + Version + " weatherboard/2.1"},
+		{"suffix then override", []ClientOption{WithUserAgentSuffix("weatherboard/2.1"), WithUserAgent("custom/1.0")}, "custom/1.0"},
+	}
+
+	for _, tc := range testCases {
+		var got string
+		client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+			got = req.Header.Get("User-Agent")
+			return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+		}, tc.opts...)
+
+		if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, apiErr)
+		}
+		if got != tc.expected {
+			t.Errorf("%s: expected User-Agent %q, got %q", tc.name, tc.expected, got)
+		}
+	}
+}
+
+// TestClone verifies that a clone applies its own options without affecting the original client.
+func TestClone(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("User-Agent")
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithClientName("dashboard"))
+
+	clone, err := client.Clone(WithUserAgentSuffix("worker/1.0"), WithDefaultRequestTimeout(time.Second))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, apiErr := clone.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if expected := "meteocat-go/" + Version + " worker/1.0 dashboard"; got != expected {
+		t.Errorf("clone: expected User-Agent %q, got %q", expected, got)
+	}
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if expected := "meteocat-go/" + Version + " dashboard"; got != expected {
+		t.Errorf("original: expected User-Agent %q, got %q", expected, got)
+	}
+	if client.requestTimeout != 0 {
+		t.Errorf("original: expected no request timeout, got %v", client.requestTimeout)
+	}
+
+	if _, err := client.Clone(WithUserAgent("")); err == nil {
+		t.Error("expected an error for an invalid option")
+	}
+}
+
+// TestClone_SharesTransport verifies that clones keep the receiver's transport, and so its idle
+// connections, unless their options change transport settings.
+func TestClone_SharesTransport(t *testing.T) {
+	client, err := NewClient(testAPIKey, nil, WithConnectionPool(50, 10, time.Minute),
+		WithProxy("http://proxy.corp:3128"), WithCompression(false))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+
+	clone, err := client.Clone(WithDefaultRequestTimeout(time.Second), WithUserAgentSuffix("worker/1.0"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if clone.httpClient.Transport != client.httpClient.Transport {
+		t.Error("expected the clone to share the receiver's transport")
+	}
+
+	pooled, err := client.Clone(WithConnectionPool(100, 20, time.Minute))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pooled.httpClient.Transport == client.httpClient.Transport {
+		t.Error("expected a new transport when the clone changes transport settings")
+	}
+	if transport := client.httpClient.Transport.(*http.Transport); transport.MaxIdleConnsPerHost != 10 {
+		t.Errorf("expected the receiver's transport to be unchanged, got %d idle connections per host", transport.MaxIdleConnsPerHost)
+	}
+
+	insecure, err := NewClient(testAPIKey, nil, WithBaseURL("https://sandbox.example.test"), WithInsecureSkipVerify())
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	if clone, _ := insecure.Clone(WithClientName("tests")); clone.httpClient.Transport != insecure.httpClient.Transport {
+		t.Error("expected the insecure client's transport to be shared")
+	}
+	if _, err := insecure.Clone(WithBaseURL(baseURL)); err == nil {
+		t.Error("expected an error when cloning an insecure client for the production host")
+	}
+}
+
+// TestMunicipalHourlyForecastWithSolar verifies that sky values are tagged and invalid coordinates are rejected.
+func TestMunicipalHourlyForecastWithSolar(t *testing.T) {
+	body := `{"codiMunicipi":"080193","dies":[{"data":"2024-06-21Z","variables":{"estatCel":{"valors":[` +
+		`{"valor":"1","data":"2024-06-21T12:00Z"},{"valor":"21","data":"2024-06-21T23:00Z"}]}}}]}`
+	calls := 0
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		calls++
+		return newTestResponse(req, http.StatusOK, "application/json", body), nil
+	})
+
+	if _, apiErr := client.MunicipalHourlyForecastWithSolar(context.Background(), "080193", model.Coordinates{}); apiErr == nil {
+		t.Fatal("expected error for zero coordinates")
+	}
+	if calls != 0 {
+		t.Fatalf("expected no request for invalid coordinates, got %d", calls)
+	}
+
+	forecast, apiErr := client.MunicipalHourlyForecastWithSolar(context.Background(), "080193", model.Coordinates{Latitude: 41.3874, Longitude: 2.1686})
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if len(forecast.Days) != 1 || len(forecast.Days[0].Sky) != 2 {
+		t.Fatalf("expected 1 day with 2 sky values, got %+v", forecast.Days)
+	}
+	if !forecast.Days[0].Sky[0].IsDay || forecast.Days[0].Sky[1].IsDay {
+		t.Errorf("expected noon to be day and 23:00 to be night, got %v and %v", forecast.Days[0].Sky[0].IsDay, forecast.Days[0].Sky[1].IsDay)
+	}
+}
+
+// TestWithBaseURL verifies that requests are sent to the configured host and invalid URLs are rejected.
+func TestWithBaseURL(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		got = req.URL.String()
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithBaseURL("http://localhost:8080/mock/"))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if got != "http://localhost:8080/mock/referencia/v1/comarques" {
+		t.Errorf("unexpected request url %q", got)
+	}
+
+	for _, raw := range []string{"", "localhost:8080", "ftp://example.com", "/relative"} {
+		if _, err := NewClient(testAPIKey, nil, WithBaseURL(raw)); err == nil {
+			t.Errorf("expected error for base url %q", raw)
+		}
+	}
+}
+
+// TestWithInsecureSkipVerify_DefaultHost verifies that insecure mode is refused against the production API.
+func TestWithInsecureSkipVerify_DefaultHost(t *testing.T) {
+	testCases := [][]ClientOption{
+		{WithInsecureSkipVerify()},
+		{WithInsecureSkipVerify(), WithBaseURL("https://API.meteo.cat:443/")},
+	}
+
+	for i, opts := range testCases {
+		if _, err := NewClient(testAPIKey, nil, opts...); err == nil {
+			t.Errorf("case %d: expected insecure mode to be rejected for the default host", i)
+		}
+	}
+}
+
+// TestWithInsecureSkipVerify_SelfSignedServer verifies that a self-signed sandbox can be reached
+// and that the caller's http.Client is left untouched.
+func TestWithInsecureSkipVerify_SelfSignedServer(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	secure, err := NewClient(testAPIKey, nil, WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	if _, apiErr := secure.Regions(context.Background()); apiErr == nil {
+		t.Fatal("expected certificate error without insecure mode")
+	}
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	insecure, err := NewClient(testAPIKey, httpClient, WithInsecureSkipVerify(), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	if _, apiErr := insecure.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if httpClient.Transport != nil {
+		t.Error("expected caller's http.Client transport to be unchanged")
+	}
+}
+
+// TestWithInsecureSkipVerify_CustomTransport verifies that non-*http.Transport round trippers are rejected.
+func TestWithInsecureSkipVerify_CustomTransport(t *testing.T) {
+	httpClient := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	})}
+	if _, err := NewClient(testAPIKey, httpClient, WithBaseURL("https://localhost:8443"), WithInsecureSkipVerify()); err == nil {
+		t.Fatal("expected error for custom transport")
+	}
+}
+
+// TestWithResponseSizeMetrics verifies that the callback receives the decoded body size,
+// including the truncated size of oversized responses.
+func TestWithResponseSizeMetrics(t *testing.T) {
+	type sample struct {
+		path  string
+		bytes int
+	}
+	var samples []sample
+	body := `[{"codi":13,"nom":"Barcelonès"}]`
+
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", body), nil
+	}, WithResponseSizeMetrics(func(path string, bytes int) {
+		samples = append(samples, sample{path, bytes})
+	}))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if len(samples) != 1 || samples[0].path != "/referencia/v1/comarques" || samples[0].bytes != len(body) {
+		t.Fatalf("expected one sample of %d bytes, got %+v", len(body), samples)
+	}
+
+	client.maxResponseBody = 10
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil {
+		t.Fatal("expected error for oversized body")
+	}
+	if len(samples) != 2 || samples[1].bytes != 10 {
+		t.Errorf("expected oversized sample of 10 bytes, got %+v", samples)
+	}
+}
+
+// TestAPIError_RequestFields verifies that errors carry the failing request and format it.
+func TestAPIError_RequestFields(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusNotFound, "application/json", `{"message":"Station not found"}`), nil
+	})
+
+	_, apiErr := client.Observations(context.Background(), "ZZ", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC))
+	if apiErr == nil {
+		t.Fatal("expected error")
+	}
+	if apiErr.Method != http.MethodGet || apiErr.Path != "/xema/v1/estacions/mesurades/ZZ/2020/06/16" {
+		t.Errorf("unexpected request fields %q %q", apiErr.Method, apiErr.Path)
+	}
+	if got, want := apiErr.Error(), "GET /xema/v1/estacions/mesurades/ZZ/2020/06/16: 404 Station not found"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	transportErr := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+	_, apiErr = transportErr.Regions(context.Background())
+	if apiErr == nil || apiErr.Path != "/referencia/v1/comarques" {
+		t.Errorf("expected path on transport error, got %+v", apiErr)
+	}
+}
+
+// TestAPIError_ManualError verifies that manually built errors keep formatting as their message.
+func TestAPIError_ManualError(t *testing.T) {
+	apiErr := &model.APIError{Code: 404, Message: "not found"}
+	if apiErr.Error() != "not found" {
+		t.Errorf("expected message only, got %q", apiErr.Error())
+	}
+}
+
+// TestWithClientName verifies that the name is appended to the User-Agent and shown by String.
+func TestWithClientName(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("User-Agent")
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithClientName("tenant-a"), WithUserAgentSuffix("weatherboard/2.1"))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if want := "meteocat-go/" + Version + " weatherboard/2.1 tenant-a"; got != want {
+		t.Errorf("expected User-Agent %q, got %q", want, got)
+	}
+	if !strings.Contains(client.String(), "Name:tenant-a") {
+		t.Errorf("expected name in String output, got %s", client.String())
+	}
+
+	for _, name := range []string{"", "  ", "bad\r\nname"} {
+		if _, err := NewClient(testAPIKey, nil, WithClientName(name)); err == nil {
+			t.Errorf("expected error for client name %q", name)
+		}
+	}
+}
+
+// TestNormalizeJSONBytes_BOM verifies that BOM-prefixed payloads are stripped or converted and unmarshal cleanly.
+func TestNormalizeJSONBytes_BOM(t *testing.T) {
+	const payload = `{"codi":13,"nom":"Barcelonès"}`
+
+	utf16Body := func(order string) []byte {
+		var out []byte
+		if order == "le" {
+			out = []byte{0xFF, 0xFE}
+		} else {
+			out = []byte{0xFE, 0xFF}
+		}
+		for _, r := range payload {
+			if order == "le" {
+				out = append(out, byte(r), byte(r>>8))
+			} else {
+				out = append(out, byte(r>>8), byte(r))
+			}
+		}
+		return out
+	}
+
+	testCases := map[string][]byte{
+		"utf-8":    append([]byte{0xEF, 0xBB, 0xBF}, payload...),
+		"utf-16le": utf16Body("le"),
+		"utf-16be": utf16Body("be"),
+	}
+
+	for name, body := range testCases {
+		normalized, apiErr := normalizeJSONBytes("application/json", body)
+		if apiErr != nil {
+			t.Fatalf("%s: unexpected error: %v", name, apiErr)
+		}
+		var region model.Region
+		if err := json.Unmarshal(normalized, &region); err != nil {
+			t.Fatalf("%s: unmarshal: %v", name, err)
+		}
+		if region.Code != 13 || region.Name != "Barcelonès" {
+			t.Errorf("%s: unexpected region %+v", name, region)
+		}
+	}
+
+	if _, apiErr := normalizeJSONBytes("application/json", []byte{0xFF, 0xFE, '{'}); apiErr == nil {
+		t.Error("expected error for odd-length UTF-16 body")
+	}
+}
+
+// TestBOMPrefixedResponse verifies that a BOM-prefixed body decodes through the client.
+func TestBOMPrefixedResponse(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json; charset=utf-8", "\xEF\xBB\xBF[{\"codi\":13,\"nom\":\"Barcelonès\"}]"), nil
+	})
+
+	regions, apiErr := client.Regions(context.Background())
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if len(regions) != 1 || regions[0].Name != "Barcelonès" {
+		t.Errorf("unexpected regions %+v", regions)
+	}
+}
+
+// TestRegion verifies that a single region is picked from the full list and that
+// unknown codes yield a 404 error.
+func TestRegion(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/referencia/v1/comarques" {
+			t.Errorf("unexpected path %q", req.URL.Path)
+		}
+		return newTestResponse(req, http.StatusOK, "application/json",
+			`[{"codi":13,"nom":"Barcelonès"},{"codi":14,"nom":"Berguedà"}]`), nil
+	})
+
+	region, apiErr := client.Region(context.Background(), 14)
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if region.Code != 14 || region.Name != "Berguedà" {
+		t.Errorf("unexpected region %+v", region)
+	}
+
+	region, apiErr = client.Region(context.Background(), 99)
+	if apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 error, got %v", apiErr)
+	}
+	if region != nil {
+		t.Errorf("expected nil region, got %+v", region)
+	}
+}
+
+// TestWithProxy verifies that requests are routed through the configured proxy, keeping the
+// http.Client timeout and leaving the caller's http.Client untouched.
+func TestWithProxy(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer proxy.Close()
+
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	client, err := NewClient(testAPIKey, httpClient, WithProxy(proxy.URL), WithBaseURL("http://api.example.test"))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if proxiedHost != "api.example.test" {
+		t.Errorf("expected request for api.example.test through the proxy, got %q", proxiedHost)
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("expected timeout to be kept, got %v", client.httpClient.Timeout)
+	}
+	if httpClient.Transport != nil {
+		t.Error("expected caller's http.Client transport to be unchanged")
+	}
+}
+
+// TestWithProxy_Invalid verifies proxy URL validation and the *http.Transport requirement.
+func TestWithProxy_Invalid(t *testing.T) {
+	for _, proxyURL := range []string{"", "proxy.corp:3128", "ftp://proxy.corp", "http://"} {
+		if _, err := NewClient(testAPIKey, nil, WithProxy(proxyURL)); err == nil {
+			t.Errorf("expected error for proxy url %q", proxyURL)
+		}
+	}
+
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) { return nil, nil })
+	if _, err := NewClient(testAPIKey, nil, WithTransport(rt), WithProxy("http://proxy.corp:3128")); err == nil {
+		t.Error("expected error when the transport is not an *http.Transport")
+	}
+}
+
+// TestWithTransport verifies that WithTransport replaces the transport and composes with WithProxy.
+func TestWithTransport(t *testing.T) {
+	called := false
+	client, err := NewClient(testAPIKey, nil, WithTransport(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	})))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if !called {
+		t.Error("expected the supplied transport to be used")
+	}
+
+	transport := &http.Transport{MaxIdleConns: 7}
+	client, err = NewClient(testAPIKey, nil, WithProxy("http://proxy.corp:3128"), WithTransport(transport))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	proxied, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok || proxied == transport || proxied.MaxIdleConns != 7 || proxied.Proxy == nil {
+		t.Errorf("expected a proxied copy of the supplied transport, got %#v", client.httpClient.Transport)
+	}
+	if transport.Proxy != nil {
+		t.Error("expected the supplied transport to be unchanged")
+	}
+}
+
+// TestWithConnectionPool verifies that a dedicated transport carries the pool settings.
+func TestWithConnectionPool(t *testing.T) {
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+	client, err := NewClient(testAPIKey, httpClient, WithConnectionPool(100, 20, 90*time.Second))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok || transport == http.DefaultTransport {
+		t.Fatalf("expected a dedicated *http.Transport, got %#v", client.httpClient.Transport)
+	}
+	if transport.MaxIdleConns != 100 || transport.MaxIdleConnsPerHost != 20 || transport.IdleConnTimeout != 90*time.Second {
+		t.Errorf("unexpected pool settings %d, %d, %v", transport.MaxIdleConns, transport.MaxIdleConnsPerHost, transport.IdleConnTimeout)
+	}
+	if client.httpClient.Timeout != 5*time.Second {
+		t.Errorf("expected timeout to be kept, got %v", client.httpClient.Timeout)
+	}
+	if httpClient.Transport != nil {
+		t.Error("expected caller's http.Client transport to be unchanged")
+	}
+
+	for _, opt := range []ClientOption{WithConnectionPool(-1, 2, 0), WithConnectionPool(1, -2, 0), WithConnectionPool(1, 2, -time.Second)} {
+		if _, err := NewClient(testAPIKey, nil, opt); err == nil {
+			t.Error("expected error for invalid pool settings")
+		}
+	}
+}
+
+// TestWithBeforeRequest verifies that the callback's changes reach the transport and its errors abort the request.
+func TestWithBeforeRequest(t *testing.T) {
+	var signature string
+	calls := 0
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		calls++
+		signature = req.Header.Get("X-Signature")
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithBeforeRequest(func(req *http.Request) error {
+		if req.Header.Get(apiKeyHeader) != testAPIKey {
+			t.Errorf("expected the API key header to be set before the callback")
+		}
+		req.Header.Set("X-Signature", "signed:"+req.URL.Path)
+		return nil
+	}))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if signature != "signed:/referencia/v1/comarques" {
+		t.Errorf("expected the signature header to reach the transport, got %q", signature)
+	}
+
+	errSigning := errors.New("signing key unavailable")
+	client, err := client.Clone(WithBeforeRequest(func(req *http.Request) error { return errSigning }))
+	if err != nil {
+		t.Fatalf("clone client: %v", err)
+	}
+	calls = 0
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil || !errors.Is(apiErr, errSigning) {
+		t.Errorf("expected the callback error, got %v", apiErr)
+	}
+	if calls != 0 {
+		t.Errorf("expected no request to be sent, got %d", calls)
+	}
+}
+
+// TestPing verifies that a healthy API returns nil and that key and connectivity failures are told apart.
+func TestPing(t *testing.T) {
+	testCases := []struct {
+		name     string
+		response func(req *http.Request) (*http.Response, error)
+		expected error
+	}{
+		{"healthy", func(req *http.Request) (*http.Response, error) {
+			return newTestResponse(req, http.StatusOK, "application/json", `[{"codi":13,"nom":"Barcelonès"}]`), nil
+		}, nil},
+		{"bad key", func(req *http.Request) (*http.Response, error) {
+			return newTestResponse(req, http.StatusUnauthorized, "application/json", `{"message":"Invalid API key"}`), nil
+		}, ErrUnauthorized},
+		{"forbidden", func(req *http.Request) (*http.Response, error) {
+			return newTestResponse(req, http.StatusForbidden, "application/json", `{"message":"Forbidden"}`), nil
+		}, ErrUnauthorized},
+		{"network error", func(req *http.Request) (*http.Response, error) {
+			return nil, errors.New("dial tcp: connection refused")
+		}, ErrUnreachable},
+	}
+
+	for _, tc := range testCases {
+		client := newTestClient(t, tc.response)
+		apiErr := client.Ping(context.Background())
+		if tc.expected == nil {
+			if apiErr != nil {
+				t.Errorf("%s: unexpected error: %v", tc.name, apiErr)
+			}
+			continue
+		}
+		if apiErr == nil || !errors.Is(apiErr, tc.expected) {
+			t.Errorf("%s: expected an error matching %v, got %v", tc.name, tc.expected, apiErr)
+		}
+		other := ErrUnauthorized
+		if tc.expected == ErrUnauthorized {
+			other = ErrUnreachable
+		}
+		if errors.Is(apiErr, other) {
+			t.Errorf("%s: expected the error not to match %v", tc.name, other)
+		}
+	}
+}
+
+// TestForecastNearest verifies that the forecast of the nearest municipality is fetched.
+func TestForecastNearest(t *testing.T) {
+	var forecastPath string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/referencia/v1/municipis" {
+			return newTestResponse(req, http.StatusOK, "application/json", `[
+				{"codi":"170792","nom":"Girona","coordenades":{"latitud":41.9794,"longitud":2.8214}},
+				{"codi":"080193","nom":"Barcelona","coordenades":{"latitud":41.3874,"longitud":2.1686}}
+			]`), nil
+		}
+		forecastPath = req.URL.Path
+		return newTestResponse(req, http.StatusOK, "application/json", `{"codiMunicipi":"080193","dies":[]}`), nil
+	})
+
+	forecast, apiErr := client.ForecastNearest(context.Background(), model.Coordinates{Latitude: 41.45, Longitude: 2.25})
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if forecastPath != "/pronostic/v1/municipalHoraria/080193" || forecast.MunicipalityCode != "080193" {
+		t.Errorf("expected Barcelona's forecast, got path %q and code %q", forecastPath, forecast.MunicipalityCode)
+	}
+
+	if _, apiErr := client.ForecastNearest(context.Background(), model.Coordinates{}); apiErr == nil {
+		t.Error("expected error for zero coordinates")
+	}
+}
+
+// TestForecastIconURLs verifies that sky codes are resolved into deduplicated day and night icons.
+func TestForecastIconURLs(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/referencia/v1/simbols" {
+			return newTestResponse(req, http.StatusOK, "application/json", `[
+				{"nom":"cel","valors":[
+					{"codi":"1","icona":"https://example.com/1.svg","icona_nit":"https://example.com/1n.svg"},
+					{"codi":"3","icona":"https://example.com/3.svg","icona_nit":"https://example.com/3.svg"}
+				]}
+			]`), nil
+		}
+		return newTestResponse(req, http.StatusOK, "application/json", `{"codiMunicipi":"080193","dies":[`+
+			`{"data":"2024-06-21Z","variables":{"estatCel":{"valors":[{"valor":"1","data":"2024-06-21T12:00Z"},{"valor":"3.0","data":"2024-06-21T13:00Z"}]}}},`+
+			`{"data":"2024-06-22Z","variables":{"estatCel":{"valors":[{"valor":"3","data":"2024-06-22T12:00Z"},{"valor":"99","data":"2024-06-22T13:00Z"}]}}}]}`), nil
+	})
+
+	urls, apiErr := client.ForecastIconURLs(context.Background(), "080193")
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	expected := []string{"https://example.com/1.svg", "https://example.com/1n.svg", "https://example.com/3.svg"}
+	if !reflect.DeepEqual(urls, expected) {
+		t.Errorf("expected %v, got %v", expected, urls)
+	}
+}
+
+// TestWithStrictKeyValidation verifies that malformed keys are rejected only when validation is enabled.
+func TestWithStrictKeyValidation(t *testing.T) {
+	wellFormed := "Ab3dEf6hIj9kLm2nOp5qRs8tUv1wXy4z"
+	if _, err := NewClient(wellFormed, nil, WithStrictKeyValidation()); err != nil {
+		t.Errorf("unexpected error for a well-formed key: %v", err)
+	}
+
+	for _, key := range []string{"short", `"` + wellFormed + `"`, wellFormed + " ", "your-api-key-goes-here-please"} {
+		_, err := NewClient(key, nil, WithStrictKeyValidation())
+		if err == nil {
+			t.Errorf("expected error for malformed key %q", key)
+			continue
+		}
+		if strings.Contains(err.Error(), key) {
+			t.Errorf("expected error not to include the key, got %v", err)
+		}
+		if _, err := NewClient(key, nil); err != nil {
+			t.Errorf("expected lenient default to accept %q, got %v", key, err)
+		}
+	}
+}
+
+// TestWithResultValidator verifies that a rejected result is reported as a distinguishable error.
+func TestWithResultValidator(t *testing.T) {
+	errEmpty := errors.New("empty region list")
+	validator := func(resource string, out any) error {
+		if regions, ok := out.(*model.RegionList); ok && len(*regions) == 0 {
+			return errEmpty
+		}
+		return nil
+	}
+
+	body := `[]`
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", body), nil
+	}, WithResultValidator(validator))
+
+	_, apiErr := client.Regions(context.Background())
+	if apiErr == nil {
+		t.Fatal("expected the empty region list to be rejected")
+	}
+	if !errors.Is(apiErr, ErrInvalidResult) || !errors.Is(apiErr, errEmpty) {
+		t.Errorf("expected error to match ErrInvalidResult and the validator error, got %v", apiErr)
+	}
+	if apiErr.Code != http.StatusOK || apiErr.Path != "/referencia/v1/comarques" {
+		t.Errorf("expected status and path to be reported, got %d %q", apiErr.Code, apiErr.Path)
+	}
+
+	body = `[{"codi":13,"nom":"Barcelonès"}]`
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Errorf("unexpected error for a valid result: %v", apiErr)
+	}
+}
+
+// TestWithLanguage verifies that the Accept-Language header is sent only when configured.
+func TestWithLanguage(t *testing.T) {
+	var header string
+	fn := func(req *http.Request) (*http.Response, error) {
+		header = req.Header.Get("Accept-Language")
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}
+
+	if _, apiErr := newTestClient(t, fn, WithLanguage(" es ")).Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if header != "es" {
+		t.Errorf("expected Accept-Language es, got %q", header)
+	}
+
+	if _, apiErr := newTestClient(t, fn).Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if header != "" {
+		t.Errorf("expected no Accept-Language by default, got %q", header)
+	}
+
+	for _, lang := range []string{"", "es\r\nX-Injected: 1"} {
+		if _, err := NewClient(testAPIKey, nil, WithLanguage(lang)); err == nil {
+			t.Errorf("expected error for language %q", lang)
+		}
+	}
+}
+
+// TestWithCodeNormalization verifies that station codes are normalized by default and can be sent as given.
+func TestWithCodeNormalization(t *testing.T) {
+	var path string
+	fn := func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}
+	date := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+
+	client := newTestClient(t, fn)
+	for _, code := range []string{"CC", "cc", " CC "} {
+		if _, apiErr := client.Observations(context.Background(), code, date); apiErr != nil {
+			t.Fatalf("unexpected error: %v", apiErr)
+		}
+		if path != "/xema/v1/estacions/mesurades/CC/2020/06/16" {
+			t.Errorf("code %q: unexpected path %q", code, path)
+		}
+	}
+
+	raw := newTestClient(t, fn, WithCodeNormalization(false))
+	if _, apiErr := raw.ValidatedObservations(context.Background(), "cc", date); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if path != "/xema/v1/estacions/validades/cc/2020/06/16" {
+		t.Errorf("expected the code to be sent as given, got path %q", path)
+	}
+}
+
+// TestWithMunicipalityCodePadding verifies that municipality codes are normalized only when opted in,
+// including in batches.
+func TestWithMunicipalityCodePadding(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	fn := func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		paths = append(paths, req.URL.Path)
+		mu.Unlock()
+		return newTestResponse(req, http.StatusOK, "application/json", `{"codiMunicipi":"025019","dies":[]}`), nil
+	}
+
+	client := newTestClient(t, fn, WithMunicipalityCodePadding())
+	for code, expected := range map[string]string{"25019": "025019", " 250019 ": "250019", "080193": "080193"} {
+		paths = nil
+		if _, apiErr := client.MunicipalHourlyForecast(context.Background(), code); apiErr != nil {
+			t.Fatalf("unexpected error: %v", apiErr)
+		}
+		if len(paths) != 1 || paths[0] != "/pronostic/v1/municipalHoraria/"+expected {
+			t.Errorf("code %q: unexpected paths %q", code, paths)
+		}
+	}
+
+	paths = nil
+	forecasts, errs := client.MunicipalHourlyForecasts(context.Background(), []string{"25019", "080193"}, 2)
+	if len(errs) != 0 || len(forecasts) != 2 {
+		t.Fatalf("unexpected results %v, errors %v", forecasts, errs)
+	}
+	if _, ok := forecasts["025019"]; !ok {
+		t.Errorf("expected results keyed by the padded code, got %v", forecasts)
+	}
+	slices.Sort(paths)
+	if !slices.Equal(paths, []string{"/pronostic/v1/municipalHoraria/025019", "/pronostic/v1/municipalHoraria/080193"}) {
+		t.Errorf("unexpected batch paths %q", paths)
+	}
+
+	paths = nil
+	if _, apiErr := newTestClient(t, fn, WithCodeNormalization(true)).MunicipalHourlyForecast(context.Background(), "25019"); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if len(paths) != 1 || paths[0] != "/pronostic/v1/municipalHoraria/25019" {
+		t.Errorf("expected the code to be sent as given without the option, got paths %q", paths)
+	}
+}
+
+// TestDo_CancelledContext verifies that an already cancelled context fails without sending a request.
+func TestDo_CancelledContext(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		requests++
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var regions model.RegionList
+	apiErr := client.do(ctx, http.MethodGet, "/referencia/v1/comarques", &regions)
+	if apiErr == nil || !errors.Is(apiErr, context.Canceled) {
+		t.Fatalf("expected a context cancellation error, got %v", apiErr)
+	}
+	if apiErr.Message != "request to METEOCAT API: context canceled" {
+		t.Errorf("unexpected message %q", apiErr.Message)
+	}
+	if requests != 0 {
+		t.Errorf("expected no request to be attempted, got %d", requests)
+	}
+}
+
+// TestDo_DeadlineDuringRequest verifies that a deadline firing while the request is in flight
+// is reported as the context error, for both buffered and streaming calls.
+func TestDo_DeadlineDuringRequest(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	var regions model.RegionList
+	apiErr := client.do(ctx, http.MethodGet, "/referencia/v1/comarques", &regions)
+	if apiErr == nil || !errors.Is(apiErr, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline error, got %v", apiErr)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	apiErr = client.ObservationsStream(ctx, "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(StationObservation) error {
+		return nil
+	})
+	if apiErr == nil || !errors.Is(apiErr, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline error from the stream, got %v", apiErr)
+	}
+}
@@ -0,0 +1,44 @@
+package meteocat
+
+import "context"
+
+// contextKey is the type of context keys defined by this package.
+type contextKey int
+
+const (
+	correlationIDKey contextKey = iota
+	apiKeyOverrideKey
+	rawCaptureKey
+)
+
+// correlationIDHeader is the header used to forward correlation IDs to the API.
+const correlationIDHeader = "X-Correlation-ID"
+
+// WithCorrelationID returns a copy of ctx carrying id, which is sent as the X-Correlation-ID header
+// on every request made with the returned context. An empty id leaves the header unset.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey, id)
+}
+
+// correlationID returns the correlation ID carried by ctx, if any.
+func correlationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey).(string)
+	return id
+}
+
+// WithAPIKeyOverride returns a copy of ctx carrying key, which requests made with the returned context
+// send instead of the client's API key. This lets multi-tenant services share a single Client across keys.
+// An empty key falls back to the client's key.
+//
+// The override is treated like the client's key: it is never logged, serialized or included in errors
+// or in the client's String output, and concurrent identical requests using different keys are never
+// deduplicated together (see WithRequestDeduplication).
+func WithAPIKeyOverride(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, apiKeyOverrideKey, key)
+}
+
+// apiKeyOverride returns the API key override carried by ctx, if any.
+func apiKeyOverride(ctx context.Context) string {
+	key, _ := ctx.Value(apiKeyOverrideKey).(string)
+	return key
+}
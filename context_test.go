@@ -0,0 +1,79 @@
+package meteocat
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestWithCorrelationID verifies that the correlation ID header is set only when the context carries one.
+func TestWithCorrelationID(t *testing.T) {
+	var got http.Header
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		got = req.Header
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	})
+
+	ctx := WithCorrelationID(context.Background(), "req-42")
+	if _, apiErr := client.Regions(ctx); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if id := got.Get("X-Correlation-ID"); id != "req-42" {
+		t.Errorf("expected X-Correlation-ID req-42, got %q", id)
+	}
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if _, ok := got["X-Correlation-Id"]; ok {
+		t.Errorf("expected no X-Correlation-ID header, got %q", got.Get("X-Correlation-ID"))
+	}
+}
+
+// TestWithAPIKeyOverride verifies that the per-request key replaces the client key and is never exposed.
+func TestWithAPIKeyOverride(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("x-api-key")
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	})
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{"override", WithAPIKeyOverride(context.Background(), "tenant-b-key"), "tenant-b-key"},
+		{"empty override", WithAPIKeyOverride(context.Background(), ""), testAPIKey},
+		{"no override", context.Background(), testAPIKey},
+	}
+	for _, tc := range testCases {
+		if _, apiErr := client.Regions(tc.ctx); apiErr != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, apiErr)
+		}
+		if got != tc.expected {
+			t.Errorf("%s: expected key %q, got %q", tc.name, tc.expected, got)
+		}
+	}
+
+	if strings.Contains(client.String(), "tenant-b-key") || strings.Contains(client.String(), testAPIKey) {
+		t.Errorf("expected String to redact keys, got %s", client.String())
+	}
+}
+
+// TestWithAPIKeyOverride_Scheme verifies that the override honors a custom header and scheme.
+func TestWithAPIKeyOverride_Scheme(t *testing.T) {
+	var got string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		got = req.Header.Get("Authorization")
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithAPIKeyHeader("Authorization", "Bearer"))
+
+	if _, apiErr := client.Regions(WithAPIKeyOverride(context.Background(), "tenant-b-key")); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if got != "Bearer tenant-b-key" {
+		t.Errorf("expected Authorization %q, got %q", "Bearer tenant-b-key", got)
+	}
+}
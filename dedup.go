@@ -0,0 +1,88 @@
+package meteocat
+
+import (
+	"context"
+	"sync"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// flightCall is an in-flight fetch whose result is shared by every caller with the same key.
+type flightCall struct {
+	done    chan struct{}
+	res     fetchResult
+	waiters int
+	cancel  context.CancelFunc
+}
+
+// flightGroup collapses concurrent fetches with the same key into a single HTTP request,
+// in the spirit of golang.org/x/sync/singleflight. It is safe for concurrent use.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flightCall)}
+}
+
+// do runs fn for key unless a call for key is already in flight, in which case it waits for that
+// call and returns its result. A caller whose ctx ends first returns a context error.
+//
+// fn runs with the values of the first caller's ctx but not its cancellation or deadline, so one
+// impatient caller cannot fail the others; it is cancelled once every caller has stopped waiting.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) fetchResult) fetchResult {
+	g.mu.Lock()
+	call, ok := g.calls[key]
+	if !ok {
+		fctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &flightCall{done: make(chan struct{}), cancel: cancel}
+		g.calls[key] = call
+		go func() {
+			defer cancel()
+			call.res = fn(fctx)
+			g.mu.Lock()
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			g.mu.Unlock()
+			close(call.done)
+		}()
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.res
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// Nobody is left to use the result; later callers start a new call.
+			if g.calls[key] == call {
+				delete(g.calls, key)
+			}
+			call.cancel()
+		}
+		g.mu.Unlock()
+		return fetchResult{apiErr: &model.APIError{Message: "request to METEOCAT API: " + ctx.Err().Error(), Err: ctx.Err()}}
+	}
+}
+
+// WithRequestDeduplication makes concurrent identical GET requests share a single HTTP call.
+// Requests are identical when they target the same resource path (including the query string);
+// each caller still decodes the shared response into its own output value.
+//
+// This is mainly useful at service startup, when several goroutines request the same large
+// reference dataset (e.g., Municipalities) at once. It composes with WithETagCache.
+//
+// Every caller waits under its own context: one whose context ends first stops waiting and returns
+// a context error without affecting the others. The shared request is cancelled only once all of
+// its callers have stopped waiting, and only the first caller's correlation ID is forwarded.
+func WithRequestDeduplication() ClientOption {
+	return func(c *Client) error {
+		c.flights = newFlightGroup()
+		return nil
+	}
+}
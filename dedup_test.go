@@ -0,0 +1,164 @@
+package meteocat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingSlowTransport answers every municipalities request after a delay and counts the calls.
+func countingSlowTransport(calls *atomic.Int32) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		time.Sleep(200 * time.Millisecond)
+		return newTestResponse(req, http.StatusOK, "application/json", `[{"codi":"080193","nom":"Barcelona"}]`), nil
+	}
+}
+
+// callMunicipalitiesConcurrently calls Municipalities from n goroutines and checks every result.
+func callMunicipalitiesConcurrently(t *testing.T, client *Client, n int) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list, apiErr := client.Municipalities(context.Background())
+			if apiErr != nil {
+				errs <- apiErr
+				return
+			}
+			if len(list) != 1 || list[0].Name != "Barcelona" {
+				t.Errorf("unexpected municipalities %+v", list)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// TestWithRequestDeduplication verifies that concurrent identical requests share one HTTP call.
+func TestWithRequestDeduplication(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, countingSlowTransport(&calls), WithRequestDeduplication())
+
+	callMunicipalitiesConcurrently(t, client, 10)
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", got)
+	}
+
+	// Once the shared call completes, a new request goes to the network again.
+	callMunicipalitiesConcurrently(t, client, 1)
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 HTTP calls after a sequential request, got %d", got)
+	}
+}
+
+// TestWithoutRequestDeduplication verifies that requests are independent by default.
+func TestWithoutRequestDeduplication(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, countingSlowTransport(&calls))
+
+	callMunicipalitiesConcurrently(t, client, 5)
+
+	if got := calls.Load(); got != 5 {
+		t.Errorf("expected 5 HTTP calls, got %d", got)
+	}
+}
+
+// TestWithRequestDeduplication_WaiterContext verifies that a waiting caller honors its own context.
+func TestWithRequestDeduplication_WaiterContext(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		close(started)
+		<-release
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithRequestDeduplication())
+
+	leaderDone := make(chan struct{})
+	go func() {
+		defer close(leaderDone)
+		client.Municipalities(context.Background())
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, apiErr := client.Municipalities(ctx); apiErr == nil {
+		t.Error("expected waiting caller to fail when its context expires")
+	}
+
+	close(release)
+	<-leaderDone
+}
+
+// TestWithRequestDeduplication_LeaderDeadline verifies that the first caller's deadline does not fail
+// the callers sharing its request.
+func TestWithRequestDeduplication_LeaderDeadline(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{})
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		time.Sleep(100 * time.Millisecond)
+		return newTestResponse(req, http.StatusOK, "application/json", `[{"codi":"080193","nom":"Barcelona"}]`), nil
+	}, WithRequestDeduplication())
+
+	leaderErr := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, apiErr := client.Municipalities(ctx)
+		leaderErr <- apiErr
+	}()
+	<-started
+
+	list, apiErr := client.Municipalities(context.Background())
+	if apiErr != nil {
+		t.Fatalf("expected the waiting caller to succeed, got %v", apiErr)
+	}
+	if len(list) != 1 {
+		t.Errorf("unexpected municipalities %+v", list)
+	}
+	if err := <-leaderErr; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the first caller to hit its deadline, got %v", err)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 HTTP call, got %d", got)
+	}
+}
+
+// TestWithRequestDeduplication_AllCallersGone verifies that the shared request is cancelled once
+// every caller has stopped waiting.
+func TestWithRequestDeduplication_AllCallersGone(t *testing.T) {
+	cancelled := make(chan struct{})
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		<-req.Context().Done()
+		close(cancelled)
+		return nil, req.Context().Err()
+	}, WithRequestDeduplication())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, apiErr := client.Municipalities(ctx); apiErr == nil {
+		t.Fatal("expected the caller to fail when its context expires")
+	}
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("expected the shared request to be cancelled")
+	}
+}
@@ -0,0 +1,219 @@
+package meteocat
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const (
+	contentEncodingHeader = "Content-Encoding"
+
+	// maxErrorBodyPreview is the maximum number of bytes of a non-JSON error body kept in APIError.Message.
+	maxErrorBodyPreview = 512
+
+	// decompressedBodyFactor sets the default decompressed body limit as a multiple of maxResponseBody.
+	decompressedBodyFactor = 4
+)
+
+// errDecompressedTooLarge is returned while decoding a compressed body once its decompressed size
+// exceeds the decompressed body limit.
+var errDecompressedTooLarge = errors.New("decompressed response body too large")
+
+// WithMaxDecompressedBody limits the size of a compressed response body after decompression to limit bytes.
+// The response size limit (10 MB) applies to the bytes received, so without this second limit a small
+// compressed body could expand into a huge payload. Decompression aborts as soon as the limit is exceeded.
+// The default is four times the response size limit. Bodies decompressed by the transport itself are
+// already plain when the client reads them and are only subject to the response size limit.
+func WithMaxDecompressedBody(limit int64) ClientOption {
+	return func(c *Client) error {
+		if limit <= 0 {
+			return fmt.Errorf("max decompressed body must be positive, got %d", limit)
+		}
+		c.maxDecompressedBody = limit
+		return nil
+	}
+}
+
+// WithCompression controls response compression. It is enabled by default: the transport asks for
+// gzip with an Accept-Encoding header and compressed bodies are decoded according to their
+// Content-Encoding. Passing false is an escape hatch for networks where a proxy mangles compressed
+// responses: no Accept-Encoding header is sent and bodies are read as-is. With a custom
+// RoundTripper other than *http.Transport (see WithTransport), only the decoding is disabled.
+func WithCompression(enabled bool) ClientOption {
+	return func(c *Client) error {
+		c.compressionDisabled = !enabled
+		c.transportChanged = true
+		return nil
+	}
+}
+
+// WithStrictUTF8 disables the automatic charset transcoding of response bodies: a body that is not
+// valid UTF-8 fails with an APIError instead of being converted from Latin-1, Windows-1252 or UTF-16,
+// and declared legacy charsets are ignored. Use it to detect upstream corruption rather than have it
+// silently repaired. A leading UTF-8 byte-order mark is still skipped.
+// Streamed responses are fully buffered in this mode so they can be validated before decoding.
+func WithStrictUTF8() ClientOption {
+	return func(c *Client) error {
+		c.strictUTF8 = true
+		return nil
+	}
+}
+
+// decodedBody wraps the response body with a decompressor matching its Content-Encoding.
+// The transport already decompresses gzip when it negotiated it; this covers bodies compressed
+// by intermediaries (e.g., proxies) that the transport leaves untouched.
+// Unknown encodings, and every body when WithCompression(false) is set, are returned as-is.
+//
+// The bytes received are limited to maxResponseBody (errBodyTooLarge) and the decompressed bytes
+// to maxDecompressedBody (errDecompressedTooLarge).
+func (c *Client) decodedBody(resp *http.Response) (io.Reader, error) {
+	wire := &limitedBodyReader{r: resp.Body, limit: c.maxResponseBody}
+	if c.compressionDisabled {
+		return wire, nil
+	}
+
+	var decoded io.Reader
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get(contentEncodingHeader)))
+	switch encoding {
+	case "gzip", "x-gzip":
+		zr, err := gzip.NewReader(wire)
+		if errors.Is(err, io.EOF) {
+			return http.NoBody, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		decoded = zr
+	case "deflate":
+		// HTTP "deflate" is zlib-wrapped, but some servers send raw DEFLATE data.
+		br := bufio.NewReader(wire)
+		header, err := br.Peek(2)
+		if errors.Is(err, io.EOF) && len(header) == 0 {
+			return http.NoBody, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read deflate header: %w", err)
+		}
+		if isZlibHeader(header) {
+			zr, err := zlib.NewReader(br)
+			if err != nil {
+				return nil, err
+			}
+			decoded = zr
+		} else {
+			decoded = flate.NewReader(br)
+		}
+	default:
+		return wire, nil
+	}
+
+	return &limitedBodyReader{r: decoded, limit: c.maxDecompressedBody, err: errDecompressedTooLarge}, nil
+}
+
+// bodyTooLargeError converts a size limit error from decodedBody into an APIError and reports the
+// exceeded limit to the response size callback. It returns nil for other errors.
+func (c *Client) bodyTooLargeError(resp *http.Response, resource string, err error) *model.APIError {
+	var limit int64
+	switch {
+	case errors.Is(err, errBodyTooLarge):
+		limit = c.maxResponseBody
+	case errors.Is(err, errDecompressedTooLarge):
+		limit = c.maxDecompressedBody
+	default:
+		return nil
+	}
+
+	if c.responseSize != nil {
+		c.responseSize(resource, int(limit))
+	}
+	return &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("%v: limit %d bytes", err, limit), Err: err}
+}
+
+// isZlibHeader reports whether b starts with a valid zlib (RFC 1950) header.
+func isZlibHeader(b []byte) bool {
+	return len(b) >= 2 && b[0]&0x0F == 8 && (uint16(b[0])<<8|uint16(b[1]))%31 == 0
+}
+
+// errorBodyPreview returns a printable prefix of a non-JSON error body, dropping invalid UTF-8
+// and control characters and truncating to maxErrorBodyPreview bytes.
+func errorBodyPreview(body []byte) string {
+	var b strings.Builder
+	truncated := false
+	for _, r := range strings.ToValidUTF8(string(body), "") {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			continue
+		}
+		if b.Len()+utf8.RuneLen(r) > maxErrorBodyPreview {
+			truncated = true
+			break
+		}
+		b.WriteRune(r)
+	}
+
+	preview := strings.TrimSpace(b.String())
+	if truncated {
+		preview += "..."
+	}
+	return preview
+}
+
+// nonJSONErrorMessage describes an error response whose body is not JSON, such as an HTML page from a
+// gateway, e.g. "upstream error (502): non-JSON response: 502 Bad Gateway". The page title, or for other
+// bodies a printable preview (see errorBodyPreview), is appended when available, so whole pages are
+// never embedded in the message.
+func nonJSONErrorMessage(status int, body []byte) string {
+	message := fmt.Sprintf("upstream error (%d): non-JSON response", status)
+
+	snippet, isHTML := htmlTitle(body)
+	if !isHTML {
+		snippet = errorBodyPreview(body)
+	}
+	if snippet != "" {
+		message += ": " + snippet
+	}
+	return message
+}
+
+// htmlTitle returns the printable content of the <title> element of an HTML body. It reports
+// false when body does not look like HTML, and an empty title when the page has none.
+func htmlTitle(body []byte) (string, bool) {
+	// Lowercase ASCII only, so that offsets in lower match those in body.
+	lower := make([]byte, len(body))
+	for i, b := range body {
+		if 'A' <= b && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		lower[i] = b
+	}
+	trimmed := bytes.TrimSpace(lower)
+	if !bytes.HasPrefix(trimmed, []byte("<!doctype html")) && !bytes.HasPrefix(trimmed, []byte("<html")) {
+		return "", false
+	}
+
+	start := bytes.Index(lower, []byte("<title"))
+	if start < 0 {
+		return "", true
+	}
+	open := bytes.IndexByte(lower[start:], '>')
+	if open < 0 {
+		return "", true
+	}
+	start += open + 1
+	end := bytes.Index(lower[start:], []byte("</title>"))
+	if end < 0 {
+		return "", true
+	}
+	return errorBodyPreview(body[start : start+end]), true
+}
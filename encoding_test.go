@@ -0,0 +1,255 @@
+package meteocat
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// compressedResponse builds a response whose body is compressed with the given encoding.
+func compressedResponse(t *testing.T, req *http.Request, status int, contentType, encoding, body string) *http.Response {
+	t.Helper()
+
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "deflate":
+		w = zlib.NewWriter(&buf)
+	case "raw-deflate":
+		fw, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		if err != nil {
+			t.Fatalf("create flate writer: %v", err)
+		}
+		w = fw
+		encoding = "deflate"
+	}
+	if _, err := w.Write([]byte(body)); err != nil {
+		t.Fatalf("compress body: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close compressor: %v", err)
+	}
+
+	resp := newTestResponse(req, status, contentType, "")
+	resp.Header.Set(contentEncodingHeader, encoding)
+	resp.Body = io.NopCloser(&buf)
+	return resp
+}
+
+// TestCompressedResponse_Success verifies that gzip and deflate bodies are decoded before unmarshalling.
+func TestCompressedResponse_Success(t *testing.T) {
+	for _, encoding := range []string{"gzip", "deflate", "raw-deflate"} {
+		client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+			return compressedResponse(t, req, http.StatusOK, "application/json", encoding, `[{"codi":13,"nom":"Barcelonès"}]`), nil
+		})
+
+		regions, apiErr := client.Regions(context.Background())
+		if apiErr != nil {
+			t.Fatalf("%s: unexpected error: %v", encoding, apiErr)
+		}
+		if len(regions) != 1 || regions[0].Name != "Barcelonès" {
+			t.Errorf("%s: unexpected regions %+v", encoding, regions)
+		}
+	}
+}
+
+// TestCompressedResponse_GzippedHTMLError verifies that a gzipped HTML error page is decoded
+// and reported by its title instead of the whole page.
+func TestCompressedResponse_GzippedHTMLError(t *testing.T) {
+	page := "<html><head><title>500 Internal Server Error</title></head><body>" +
+		strings.Repeat("upstream failure ", 100) + "</body></html>"
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return compressedResponse(t, req, http.StatusInternalServerError, "text/html", "gzip", page), nil
+	})
+
+	_, apiErr := client.Regions(context.Background())
+	if apiErr == nil {
+		t.Fatal("expected error")
+	}
+	if apiErr.Code != http.StatusInternalServerError {
+		t.Errorf("expected code 500, got %d", apiErr.Code)
+	}
+	if expected := "upstream error (500): non-JSON response: 500 Internal Server Error"; apiErr.Message != expected {
+		t.Errorf("expected %q, got %q", expected, apiErr.Message)
+	}
+}
+
+// TestHTMLErrorPage verifies that gateway HTML pages produce a concise message while the full body
+// stays available to the response capture callback, and that other non-JSON bodies are truncated.
+func TestHTMLErrorPage(t *testing.T) {
+	page := "<!DOCTYPE html>\n<html><head><TITLE>502 Bad Gateway</TITLE></head><body><center><h1>502 Bad Gateway</h1></center>" +
+		strings.Repeat("<p>nginx</p>", 200) + "</body></html>"
+	var captured []byte
+	body, contentType := page, "text/html; charset=utf-8"
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusBadGateway, contentType, body), nil
+	}, WithResponseCapture(func(path string, status int, b []byte) {
+		captured = append([]byte(nil), b...)
+	}))
+
+	_, apiErr := client.Regions(context.Background())
+	if apiErr == nil || apiErr.Code != http.StatusBadGateway {
+		t.Fatalf("expected a 502 error, got %v", apiErr)
+	}
+	if expected := "upstream error (502): non-JSON response: 502 Bad Gateway"; apiErr.Message != expected {
+		t.Errorf("expected %q, got %q", expected, apiErr.Message)
+	}
+	if string(captured) != page {
+		t.Error("expected the full page to reach the response capture callback")
+	}
+
+	body, contentType = strings.Repeat("service unavailable ", 100), "text/plain"
+	_, apiErr = client.Regions(context.Background())
+	if apiErr == nil || !strings.HasPrefix(apiErr.Message, "upstream error (502): non-JSON response: service unavailable") ||
+		!strings.HasSuffix(apiErr.Message, "...") || len(apiErr.Message) > 600 {
+		t.Errorf("expected a truncated plain text snippet, got %q", apiErr.Message)
+	}
+}
+
+// TestErrorBodyPreview verifies that invalid UTF-8 and control characters are dropped.
+func TestErrorBodyPreview(t *testing.T) {
+	got := errorBodyPreview([]byte("bad\xff\x00 gateway\x1b"))
+	if got != "bad gateway" {
+		t.Errorf("expected %q, got %q", "bad gateway", got)
+	}
+	if got := errorBodyPreview([]byte{0x1f, 0x8b, 0x00}); got != "" {
+		t.Errorf("expected empty preview for binary data, got %q", got)
+	}
+}
+
+// TestWithMaxDecompressedBody verifies that a highly compressible body is rejected once it expands
+// past the decompressed limit, even though its compressed size is well within the response size limit.
+func TestWithMaxDecompressedBody(t *testing.T) {
+	body := `[{"codi":1,"nom":"` + strings.Repeat("a", 1<<20) + `"}]`
+	var compressedSize int
+
+	for _, encoding := range []string{"gzip", "deflate"} {
+		var sizes []int
+		client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+			resp := compressedResponse(t, req, http.StatusOK, "application/json", encoding, body)
+			raw, _ := io.ReadAll(resp.Body)
+			compressedSize = len(raw)
+			resp.Body = io.NopCloser(bytes.NewReader(raw))
+			return resp, nil
+		}, WithMaxDecompressedBody(64<<10), WithResponseSizeMetrics(func(_ string, n int) { sizes = append(sizes, n) }))
+
+		_, apiErr := client.Regions(context.Background())
+		if apiErr == nil || !strings.Contains(apiErr.Message, "decompressed response body too large") {
+			t.Fatalf("%s: expected decompressed size error, got %v", encoding, apiErr)
+		}
+		if !errors.Is(apiErr, errDecompressedTooLarge) {
+			t.Errorf("%s: expected error to wrap errDecompressedTooLarge", encoding)
+		}
+		if compressedSize >= 64<<10 {
+			t.Errorf("%s: expected a small compressed body, got %d bytes", encoding, compressedSize)
+		}
+		if len(sizes) != 1 || sizes[0] != 64<<10 {
+			t.Errorf("%s: expected the limit to be reported as size, got %v", encoding, sizes)
+		}
+	}
+
+	if _, err := NewClient(testAPIKey, nil, WithMaxDecompressedBody(0)); err == nil {
+		t.Error("expected error for a non-positive limit")
+	}
+}
+
+// TestWithStrictUTF8 verifies that invalid UTF-8 fails in strict mode and is transcoded by default.
+func TestWithStrictUTF8(t *testing.T) {
+	fn := func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", "[{\"codi\":13,\"nom\":\"Barcelon\xE8s\"}]"), nil
+	}
+
+	regions, apiErr := newTestClient(t, fn).Regions(context.Background())
+	if apiErr != nil {
+		t.Fatalf("unexpected error in default mode: %v", apiErr)
+	}
+	if len(regions) != 1 || regions[0].Name != "Barcelonès" {
+		t.Errorf("expected Latin-1 transcoding, got %+v", regions)
+	}
+
+	strict := newTestClient(t, fn, WithStrictUTF8())
+	if _, apiErr := strict.Regions(context.Background()); apiErr == nil || !strings.Contains(apiErr.Message, "invalid UTF-8 in response body at byte 27") {
+		t.Fatalf("expected invalid UTF-8 error in strict mode, got %v", apiErr)
+	}
+	err := strict.ObservationsStream(context.Background(), "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(StationObservation) error { return nil })
+	if err == nil || !strings.Contains(err.Message, "invalid UTF-8") {
+		t.Errorf("expected invalid UTF-8 error when streaming in strict mode, got %v", err)
+	}
+}
+
+// TestWithCompression verifies that gzip is negotiated by default and no Accept-Encoding header
+// is sent when compression is disabled.
+func TestWithCompression(t *testing.T) {
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	for _, tc := range []struct {
+		opts     []ClientOption
+		expected string
+	}{
+		{nil, "gzip"},
+		{[]ClientOption{WithCompression(true)}, "gzip"},
+		{[]ClientOption{WithCompression(false)}, ""},
+	} {
+		client, err := NewClient(testAPIKey, nil, append(tc.opts, WithBaseURL(server.URL))...)
+		if err != nil {
+			t.Fatalf("create client: %v", err)
+		}
+		if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+			t.Fatalf("unexpected error: %v", apiErr)
+		}
+		if acceptEncoding != tc.expected {
+			t.Errorf("expected Accept-Encoding %q, got %q", tc.expected, acceptEncoding)
+		}
+	}
+
+	// With compression disabled, a compressed body is not decoded.
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return compressedResponse(t, req, http.StatusOK, "application/json", "gzip", `[]`), nil
+	}, WithCompression(false))
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil {
+		t.Error("expected an error decoding a gzip body with compression disabled")
+	}
+}
+
+// TestWithCompression_Clone verifies that a clone can turn compression back on, keeping other transport settings.
+func TestWithCompression_Clone(t *testing.T) {
+	client, err := NewClient(testAPIKey, nil, WithConnectionPool(50, 10, time.Minute), WithCompression(false))
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	if !client.httpClient.Transport.(*http.Transport).DisableCompression {
+		t.Fatal("expected compression to be disabled on the base client")
+	}
+
+	clone, err := client.Clone(WithCompression(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transport := clone.httpClient.Transport.(*http.Transport)
+	if transport.DisableCompression || clone.compressionDisabled {
+		t.Error("expected the clone to negotiate and decode compression again")
+	}
+	if transport.MaxIdleConnsPerHost != 10 {
+		t.Errorf("expected the connection pool to be kept, got %d idle connections per host", transport.MaxIdleConnsPerHost)
+	}
+	if !client.httpClient.Transport.(*http.Transport).DisableCompression {
+		t.Error("expected the base client to be unchanged")
+	}
+}
@@ -0,0 +1,28 @@
+package endpoint
+
+import (
+	"context"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const coastalForecastPath = "/pronostic/v1/maritima"
+
+// CoastalForecast fetches the maritime forecast for the Catalan coast.
+// The forecast is split into coastal zones, each with validity periods describing
+// the expected sea state (Douglas scale), significant wave height and wind over water.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//
+// Returns:
+//   - model.CoastalForecast: maritime forecast for every coastal zone
+//   - *model.APIError: error if the request fails or data cannot be parsed
+func CoastalForecast(ctx context.Context, do DoFunc) (model.CoastalForecast, *model.APIError) {
+	var forecast model.CoastalForecast
+	if err := do(ctx, "GET", coastalForecastPath, &forecast); err != nil {
+		return model.CoastalForecast{}, err
+	}
+	return forecast, nil
+}
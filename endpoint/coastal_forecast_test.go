@@ -0,0 +1,105 @@
+package endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const coastalForecastFixture = `{
+	"dataPublicacio": "2020-08-20T05:00Z",
+	"zones": [
+		{
+			"codi": "1",
+			"nom": "Costa Brava",
+			"periodes": [
+				{
+					"dataInici": "2020-08-20T06:00Z",
+					"dataFi": "2020-08-20T18:00Z",
+					"estatMar": 3,
+					"alturaOnaMin": 0.5,
+					"alturaOnaMax": 1.0,
+					"direccioVent": "NE",
+					"velocitatVentMin": 10,
+					"velocitatVentMax": 25
+				}
+			]
+		},
+		{
+			"codi": "2",
+			"nom": "Costa Daurada",
+			"periodes": []
+		}
+	]
+}`
+
+// TestCoastalForecast_Success verifies that CoastalForecast requests the maritime path
+// and parses a representative response.
+func TestCoastalForecast_Success(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		if path != coastalForecastPath {
+			t.Errorf(testErrorExpectedPath, coastalForecastPath, path)
+		}
+
+		forecastPtr, ok := out.(*model.CoastalForecast)
+		if !ok {
+			t.Fatalf("expected *model.CoastalForecast, got %T", out)
+		}
+		if err := json.Unmarshal([]byte(coastalForecastFixture), forecastPtr); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	forecast, apiErr := CoastalForecast(context.Background(), mockDo)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+
+	if !forecast.IssuedAt.Equal(model.NewMeteocatTime(time.Date(2020, 8, 20, 5, 0, 0, 0, time.UTC))) {
+		t.Errorf("unexpected issue time %v", forecast.IssuedAt)
+	}
+	if len(forecast.Zones) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(forecast.Zones))
+	}
+
+	zone := forecast.Zones[0]
+	if zone.Name != "Costa Brava" || len(zone.Periods) != 1 {
+		t.Fatalf("unexpected zone %+v", zone)
+	}
+
+	period := zone.Periods[0]
+	if period.SeaState != model.SeaStateSlight {
+		t.Errorf("expected sea state %v, got %v", model.SeaStateSlight, period.SeaState)
+	}
+	if period.SeaState.String() != "maror" {
+		t.Errorf("expected sea state name maror, got %s", period.SeaState)
+	}
+	if period.WaveHeightMax != 1.0 || period.WindDirection != "NE" || period.WindSpeedMax != 25 {
+		t.Errorf("unexpected period %+v", period)
+	}
+}
+
+// TestCoastalForecast_APIError verifies that API errors are properly propagated.
+func TestCoastalForecast_APIError(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		return &model.APIError{Code: 503, Message: "Service unavailable"}
+	}
+
+	forecast, apiErr := CoastalForecast(context.Background(), mockDo)
+	if apiErr == nil {
+		t.Fatal(testErrorExpectedErrorNil)
+	}
+	if apiErr.Code != 503 {
+		t.Errorf("expected error code 503, got %d", apiErr.Code)
+	}
+	if len(forecast.Zones) != 0 {
+		t.Errorf("expected empty forecast, got %v", forecast)
+	}
+}
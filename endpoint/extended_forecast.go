@@ -0,0 +1,35 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const municipalExtendedForecastPath = "/pronostic/v1/municipal"
+
+// MunicipalExtendedForecast fetches the daily forecast of a municipality for the coming days
+// (typically 8), extending beyond the 72-hour horizon of MunicipalHourlyForecast.
+// Each day carries the minimum and maximum temperatures, the probability of precipitation
+// and the sky state symbol code.
+//
+// The municipality code must be obtained from the municipalities metadata endpoint.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "080193")
+//
+// Returns:
+//   - model.MunicipalityExtendedForecast: daily forecast values for each day
+//   - *model.APIError: error if the request fails, municipality code is invalid, or data cannot be parsed
+func MunicipalExtendedForecast(ctx context.Context, do DoFunc, municipalityCode string) (model.MunicipalityExtendedForecast, *model.APIError) {
+	resource := fmt.Sprintf("%s/%s", municipalExtendedForecastPath, municipalityCode)
+
+	var forecast model.MunicipalityExtendedForecast
+	if err := do(ctx, "GET", resource, &forecast); err != nil {
+		return model.MunicipalityExtendedForecast{}, err
+	}
+	return forecast, nil
+}
@@ -0,0 +1,94 @@
+package endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const extendedForecastFixture = `{
+	"codiMunicipi": "080193",
+	"dies": [
+		{
+			"data": "2020-08-20Z",
+			"variables": {
+				"tmax": {"unitat": "°C", "valor": 30.1},
+				"tmin": {"unitat": "°C", "valor": "21.4"},
+				"precipitacio": {"unitat": "%", "valor": "10"},
+				"estatCel": {"valor": 3}
+			}
+		},
+		{
+			"data": "2020-08-27Z",
+			"variables": {
+				"tmax": {"unitat": "°C", "valor": 27.0},
+				"tmin": {"unitat": "°C", "valor": 19.5}
+			}
+		}
+	]
+}`
+
+// TestMunicipalExtendedForecast_Success verifies the path and the decoded daily values.
+func TestMunicipalExtendedForecast_Success(t *testing.T) {
+	expectedPath := "/pronostic/v1/municipal/080193"
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+
+		forecastPtr, ok := out.(*model.MunicipalityExtendedForecast)
+		if !ok {
+			t.Fatalf("expected *model.MunicipalityExtendedForecast, got %T", out)
+		}
+		if err := json.Unmarshal([]byte(extendedForecastFixture), forecastPtr); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	forecast, apiErr := MunicipalExtendedForecast(context.Background(), mockDo, "080193")
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if forecast.MunicipalityCode != "080193" {
+		t.Errorf(testErrorExpectedMunicipalityCode, "080193", forecast.MunicipalityCode)
+	}
+	if len(forecast.Days) != 2 {
+		t.Fatalf("expected 2 days, got %d", len(forecast.Days))
+	}
+
+	first := forecast.Days[0]
+	if min, max, ok := first.TemperatureRange(); !ok || min != 21.4 || max != 30.1 {
+		t.Errorf("expected range 21.4-30.1, got %v-%v (ok=%t)", min, max, ok)
+	}
+	if first.Variables.PrecipitationProbability.Value != "10" || first.Variables.SkyCode.Value.SymbolCode() != "3" {
+		t.Errorf("unexpected variables %+v", first.Variables)
+	}
+	if last := forecast.Days[1]; last.Date.String() != "2020-08-27Z" || last.Variables.PrecipitationProbability != nil {
+		t.Errorf("unexpected last day %+v", last)
+	}
+}
+
+// TestMunicipalExtendedForecast_APIError verifies that API errors are properly propagated.
+func TestMunicipalExtendedForecast_APIError(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		return &model.APIError{Code: 404, Message: "Municipality not found"}
+	}
+
+	forecast, apiErr := MunicipalExtendedForecast(context.Background(), mockDo, "999999")
+	if apiErr == nil {
+		t.Fatal(testErrorExpectedErrorNil)
+	}
+	if apiErr.Code != 404 {
+		t.Errorf("expected error code 404, got %d", apiErr.Code)
+	}
+	if forecast.MunicipalityCode != "" || forecast.Days != nil {
+		t.Errorf("expected empty forecast, got %+v", forecast)
+	}
+}
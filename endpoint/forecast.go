@@ -3,6 +3,7 @@ package endpoint
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/luisfrmoro/meteocat/model"
 )
@@ -62,3 +63,71 @@ func MunicipalHourlyForecast(ctx context.Context, do DoFunc, municipalityCode st
 	}
 	return forecast, nil
 }
+
+// MunicipalHourlyForecasts fetches hourly forecasts for several municipalities concurrently.
+// Requests are issued by a bounded pool of at most concurrency workers (values below 1 are
+// treated as 1). Duplicate codes are fetched only once.
+//
+// Successful forecasts and per-code failures are returned in separate maps keyed by municipality
+// code, so a failure for one municipality does not discard the others. If ctx is cancelled before
+// every code has been requested, the remaining codes are reported in the error map.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - codes: municipality codes to fetch (e.g., "250019", "080193")
+//   - concurrency: maximum number of requests in flight at the same time
+//
+// Returns:
+//   - map[string]model.MunicipalityHourlyForecast: forecasts for the codes that succeeded
+//   - map[string]*model.APIError: errors for the codes that failed
+func MunicipalHourlyForecasts(ctx context.Context, do DoFunc, codes []string, concurrency int) (map[string]model.MunicipalityHourlyForecast, map[string]*model.APIError) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	forecasts := make(map[string]model.MunicipalityHourlyForecast, len(codes))
+	errs := make(map[string]*model.APIError)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	jobs := make(chan string)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for code := range jobs {
+				forecast, apiErr := MunicipalHourlyForecast(ctx, do, code)
+
+				mu.Lock()
+				if apiErr != nil {
+					errs[code] = apiErr
+				} else {
+					forecasts[code] = forecast
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		if _, dup := seen[code]; dup {
+			continue
+		}
+		seen[code] = struct{}{}
+
+		select {
+		case jobs <- code:
+		case <-ctx.Done():
+			mu.Lock()
+			errs[code] = &model.APIError{Message: fmt.Sprintf("request to METEOCAT API: %v", ctx.Err()), Err: ctx.Err()}
+			mu.Unlock()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return forecasts, errs
+}
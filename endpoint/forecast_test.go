@@ -3,6 +3,9 @@ package endpoint
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -27,7 +30,7 @@ func TestMunicipalHourlyForecast_Success(t *testing.T) {
 		MunicipalityCode: "250019",
 		Days: []model.ForecastDay{
 			{
-				Date: "2020-08-20Z",
+				Date: model.NewMeteocatDate(time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)),
 				Variables: &model.ForecastVariables{
 					Temperature: &model.Temperature{
 						Unit: "°C",
@@ -58,7 +61,7 @@ func TestMunicipalHourlyForecast_Success(t *testing.T) {
 				},
 			},
 			{
-				Date: "2020-08-21Z",
+				Date: model.NewMeteocatDate(time.Date(2020, 8, 21, 0, 0, 0, 0, time.UTC)),
 				Variables: &model.ForecastVariables{
 					Temperature: &model.Temperature{
 						Unit: "°C",
@@ -188,7 +191,7 @@ func TestMunicipalHourlyForecast_EmptyVariables(t *testing.T) {
 		MunicipalityCode: "250019",
 		Days: []model.ForecastDay{
 			{
-				Date:      "2020-08-20Z",
+				Date:      model.NewMeteocatDate(time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)),
 				Variables: &model.ForecastVariables{},
 			},
 		},
@@ -288,7 +291,7 @@ func validateForecastResponse(t *testing.T, forecast, expected model.Municipalit
 func validateForecastDay(t *testing.T, dayIndex int, day, expectedDay model.ForecastDay) {
 	t.Helper()
 
-	if day.Date != expectedDay.Date {
+	if !day.Date.Equal(expectedDay.Date.Time) {
 		t.Errorf("day %d: expected date %s, got %s", dayIndex, expectedDay.Date, day.Date)
 	}
 
@@ -340,3 +343,111 @@ func parseTime(t *testing.T, timeStr string) time.Time {
 	t.Fatalf("unable to parse time: %s", timeStr)
 	return time.Time{}
 }
+
+// TestMunicipalHourlyForecasts_PartialFailure verifies that batch retrieval returns
+// successful forecasts and per-code errors in separate maps.
+func TestMunicipalHourlyForecasts_PartialFailure(t *testing.T) {
+	found := map[string]bool{"250019": true, "080193": true}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		time.Sleep(5 * time.Millisecond)
+
+		code := strings.TrimPrefix(path, municipalHourlyForecastPath+"/")
+		if !found[code] {
+			return &model.APIError{Code: 404, Message: "Municipality not found"}
+		}
+
+		forecastPtr := out.(*model.MunicipalityHourlyForecast)
+		*forecastPtr = model.MunicipalityHourlyForecast{MunicipalityCode: code}
+		return nil
+	}
+
+	codes := []string{"250019", "999998", "080193", "999999", "250019"}
+	forecasts, errs := MunicipalHourlyForecasts(context.Background(), mockDo, codes, 2)
+
+	if len(forecasts) != 2 {
+		t.Fatalf("expected 2 forecasts, got %d", len(forecasts))
+	}
+	for code := range found {
+		if forecasts[code].MunicipalityCode != code {
+			t.Errorf(testErrorExpectedMunicipalityCode, code, forecasts[code].MunicipalityCode)
+		}
+	}
+
+	if len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %d", len(errs))
+	}
+	for _, code := range []string{"999998", "999999"} {
+		if errs[code] == nil || errs[code].Code != 404 {
+			t.Errorf("expected 404 error for %s, got %v", code, errs[code])
+		}
+	}
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+// TestMunicipalHourlyForecasts_ContextCancellation verifies that a cancelled context
+// reports every code as failed.
+func TestMunicipalHourlyForecasts_ContextCancellation(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if ctx.Err() != nil {
+			return &model.APIError{Message: "context cancelled"}
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	forecasts, errs := MunicipalHourlyForecasts(ctx, mockDo, []string{"250019", "080193"}, 1)
+	if len(forecasts) != 0 {
+		t.Errorf("expected no forecasts, got %d", len(forecasts))
+	}
+	if len(errs) != 2 {
+		t.Errorf("expected 2 errors, got %d", len(errs))
+	}
+}
+
+// TestMunicipalHourlyForecasts_SkippedAfterCancel verifies that codes skipped after a cancellation
+// report the context error.
+func TestMunicipalHourlyForecasts_SkippedAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		// Keep the only worker busy so the remaining codes are skipped.
+		cancel()
+		time.Sleep(20 * time.Millisecond)
+		return &model.APIError{Message: "request to METEOCAT API: context canceled", Err: ctx.Err()}
+	}
+
+	_, errs := MunicipalHourlyForecasts(ctx, mockDo, []string{"250019", "080193", "170792"}, 1)
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %d", len(errs))
+	}
+	for code, apiErr := range errs {
+		if !errors.Is(apiErr, context.Canceled) {
+			t.Errorf("%s: expected context.Canceled, got %v", code, apiErr)
+		}
+		if apiErr.Message != "request to METEOCAT API: context canceled" {
+			t.Errorf("%s: unexpected message %q", code, apiErr.Message)
+		}
+	}
+}
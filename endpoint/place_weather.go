@@ -0,0 +1,48 @@
+package endpoint
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// PlaceWeather fetches the hourly forecast of a municipality and the observations of a station for
+// a day concurrently, and combines them into a single model.PlaceWeather.
+//
+// Failures are reported per part in ForecastErr and ObservationsErr, so a forecast is still returned
+// when the observations are not available (e.g., 404 for a day without data) and vice versa. The
+// returned error is only set when both requests fail; it is the forecast error. Both requests use ctx,
+// so cancelling it aborts both.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "250019")
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - obsDate: the day for which observations are requested
+//
+// Returns:
+//   - model.PlaceWeather: the forecast and observations, with per-part errors
+//   - *model.APIError: error if both requests fail
+func PlaceWeather(ctx context.Context, do DoFunc, municipalityCode, stationCode string, obsDate time.Time) (model.PlaceWeather, *model.APIError) {
+	var place model.PlaceWeather
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		place.Forecast, place.ForecastErr = MunicipalHourlyForecast(ctx, do, municipalityCode)
+	}()
+	go func() {
+		defer wg.Done()
+		place.Observations, place.ObservationsErr = Observations(ctx, do, stationCode, obsDate)
+	}()
+	wg.Wait()
+
+	if place.ForecastErr != nil && place.ObservationsErr != nil {
+		return place, place.ForecastErr
+	}
+	return place, nil
+}
@@ -0,0 +1,54 @@
+package endpoint
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// TestPlaceWeather_PartialFailure verifies that a failing observations request does not discard the forecast.
+func TestPlaceWeather_PartialFailure(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if strings.HasPrefix(path, stationObservationsPath) {
+			return &model.APIError{Code: http.StatusNotFound, Message: "no data"}
+		}
+		if path != municipalHourlyForecastPath+"/250019" {
+			t.Errorf("unexpected path %s", path)
+		}
+		out.(*model.MunicipalityHourlyForecast).MunicipalityCode = "250019"
+		return nil
+	}
+
+	place, apiErr := PlaceWeather(context.Background(), mockDo, "250019", "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC))
+	if apiErr != nil {
+		t.Fatalf("expected no error for a partial failure, got %v", apiErr)
+	}
+	if place.ForecastErr != nil || place.Forecast.MunicipalityCode != "250019" {
+		t.Errorf("expected the forecast, got %+v (err %v)", place.Forecast, place.ForecastErr)
+	}
+	if place.ObservationsErr == nil || place.ObservationsErr.Code != http.StatusNotFound || place.Observations != nil {
+		t.Errorf("expected a 404 observations error, got %v", place.ObservationsErr)
+	}
+	if place.Complete() {
+		t.Error("expected an incomplete result")
+	}
+}
+
+// TestPlaceWeather_Cancelled verifies that both parts fail when the context is cancelled.
+func TestPlaceWeather_Cancelled(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		<-ctx.Done()
+		return &model.APIError{Message: ctx.Err().Error()}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	place, apiErr := PlaceWeather(ctx, mockDo, "250019", "CC", time.Now())
+	if apiErr == nil || apiErr != place.ForecastErr || place.ObservationsErr == nil {
+		t.Errorf("expected both parts to fail, got %v and %v", place.ForecastErr, place.ObservationsErr)
+	}
+}
@@ -0,0 +1,34 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const regionalForecastPath = "/pronostic/v1/comarcal"
+
+// RegionalForecast fetches the textual forecast for a region (comarca).
+// The forecast covers a validity window split into morning, afternoon and night periods,
+// each with a sky symbol code, a temperature trend and a textual description.
+//
+// The region code must be obtained from the regions metadata endpoint.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - regionCode: the numeric identifier of the region (e.g., 13 for Barcelonès)
+//
+// Returns:
+//   - model.RegionalForecast: forecast periods for the region
+//   - *model.APIError: error if the request fails, region code is invalid, or data cannot be parsed
+func RegionalForecast(ctx context.Context, do DoFunc, regionCode int) (model.RegionalForecast, *model.APIError) {
+	resource := fmt.Sprintf("%s/%d", regionalForecastPath, regionCode)
+
+	var forecast model.RegionalForecast
+	if err := do(ctx, "GET", resource, &forecast); err != nil {
+		return model.RegionalForecast{}, err
+	}
+	return forecast, nil
+}
@@ -0,0 +1,91 @@
+package endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const regionalForecastFixture = `{
+	"comarca": {"codi": 13, "nom": "Barcelonès"},
+	"dataInici": "2020-08-20T00:00Z",
+	"dataFi": "2020-08-21T00:00Z",
+	"franges": [
+		{"franja": "mati", "data": "2020-08-20Z", "estatCel": "1", "tendenciaTemperatura": "ascens", "descripcio": "Cel serè."},
+		{"franja": "tarda", "data": "2020-08-20Z", "estatCel": "3", "tendenciaTemperatura": "sense canvis", "descripcio": "Nuvolositat variable."},
+		{"franja": "nit", "data": "2020-08-20Z", "estatCel": "1", "tendenciaTemperatura": "descens", "descripcio": "Cel serè."}
+	]
+}`
+
+// TestRegionalForecast_Success verifies that RegionalForecast builds the path from
+// the region code and parses a representative response.
+func TestRegionalForecast_Success(t *testing.T) {
+	expectedPath := "/pronostic/v1/comarcal/13"
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+
+		forecastPtr, ok := out.(*model.RegionalForecast)
+		if !ok {
+			t.Fatalf("expected *model.RegionalForecast, got %T", out)
+		}
+		if err := json.Unmarshal([]byte(regionalForecastFixture), forecastPtr); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	forecast, apiErr := RegionalForecast(context.Background(), mockDo, 13)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+
+	if forecast.Region.Code != 13 || forecast.Region.Name != "Barcelonès" {
+		t.Errorf("unexpected region %+v", forecast.Region)
+	}
+	if len(forecast.Periods) != 3 {
+		t.Fatalf("expected 3 periods, got %d", len(forecast.Periods))
+	}
+
+	expected := []struct {
+		segment model.DaySegment
+		trend   model.TemperatureTrend
+	}{
+		{model.DaySegmentMorning, model.TemperatureTrendRising},
+		{model.DaySegmentAfternoon, model.TemperatureTrendSteady},
+		{model.DaySegmentNight, model.TemperatureTrendFalling},
+	}
+	for i, want := range expected {
+		if forecast.Periods[i].Segment != want.segment {
+			t.Errorf("period %d: expected segment %s, got %s", i, want.segment, forecast.Periods[i].Segment)
+		}
+		if forecast.Periods[i].TemperatureTrend != want.trend {
+			t.Errorf("period %d: expected trend %s, got %s", i, want.trend, forecast.Periods[i].TemperatureTrend)
+		}
+	}
+}
+
+// TestRegionalForecast_APIError verifies that API errors are properly propagated.
+func TestRegionalForecast_APIError(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		return &model.APIError{Code: 404, Message: "Region not found"}
+	}
+
+	forecast, apiErr := RegionalForecast(context.Background(), mockDo, 999)
+	if apiErr == nil {
+		t.Fatal(testErrorExpectedErrorNil)
+	}
+	if apiErr.Code != 404 {
+		t.Errorf("expected error code 404, got %d", apiErr.Code)
+	}
+	if forecast.Region.Code != 0 || len(forecast.Periods) != 0 {
+		t.Errorf("expected empty forecast, got %v", forecast)
+	}
+}
@@ -0,0 +1,59 @@
+package endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// StaticDo returns a DoFunc serving canned JSON responses, intended for tests of code built on this package.
+// Responses are keyed by resource, e.g. "/referencia/v1/comarques" or "/xema/v1/estacions/mesurades/CC/2020/06/16".
+// A resource with a query string matches its exact key first and falls back to the key without the query.
+// Unknown resources yield a 404 APIError; invalid JSON yields an unmarshal APIError.
+// The responses map must not be modified while the DoFunc is in use.
+func StaticDo(responses map[string][]byte) DoFunc {
+	return func(ctx context.Context, method, resource string, out any) *model.APIError {
+		if err := ctx.Err(); err != nil {
+			return &model.APIError{Message: fmt.Sprintf("request cancelled: %v", err)}
+		}
+
+		body, ok := responses[resource]
+		if !ok {
+			path, _, _ := strings.Cut(resource, "?")
+			body, ok = responses[path]
+		}
+		if !ok {
+			return &model.APIError{Code: http.StatusNotFound, Message: fmt.Sprintf("no fixture for %s %s", method, resource)}
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return &model.APIError{Code: http.StatusOK, Message: fmt.Sprintf("unmarshal response: %v", err)}
+		}
+		return nil
+	}
+}
+
+// ErrorDo returns a DoFunc that fails every request with err, intended for tests of error handling.
+func ErrorDo(err *model.APIError) DoFunc {
+	return func(ctx context.Context, method, resource string, out any) *model.APIError {
+		return err
+	}
+}
+
+// ExpectOut wraps do so that every request must decode into a *T. Any other out value fails
+// before do is called with an APIError naming both types, e.g. "out has type *model.StationList,
+// expected *model.RegionList", instead of a less obvious unmarshal error from deep inside the JSON.
+// It is useful with StaticDo or custom DoFuncs when testing code that issues requests of a known type.
+func ExpectOut[T any](do DoFunc) DoFunc {
+	return func(ctx context.Context, method, resource string, out any) *model.APIError {
+		if _, ok := out.(*T); !ok {
+			return &model.APIError{Message: fmt.Sprintf("out has type %T, expected %v", out, reflect.TypeFor[*T]())}
+		}
+		return do(ctx, method, resource, out)
+	}
+}
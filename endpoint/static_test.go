@@ -0,0 +1,92 @@
+package endpoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// TestStaticDo_Fixtures verifies that StaticDo drives Regions and Observations from recorded JSON.
+func TestStaticDo_Fixtures(t *testing.T) {
+	do := StaticDo(map[string][]byte{
+		"/referencia/v1/comarques": []byte(`[{"codi":13,"nom":"Barcelonès"},{"codi":24,"nom":"Osona"}]`),
+		"/xema/v1/estacions/mesurades/CC/2020/06/16": []byte(`[{"codi":"CC","variables":[{"codi":30,"lectures":[
+			{"data":"2020-06-16T00:00Z","valor":0.6,"estat":"V","baseHoraria":"SH"},
+			{"data":"2020-06-16T00:30Z","valor":0.6,"estat":"V","baseHoraria":"SH"}]}]}]`),
+	})
+	ctx := context.Background()
+
+	regions, apiErr := Regions(ctx, do)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(regions) != 2 || regions[1].Name != "Osona" {
+		t.Errorf("unexpected regions %+v", regions)
+	}
+
+	observations, apiErr := Observations(ctx, do, "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC))
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	validateSecondVariable(t, observations[0].Variables[0])
+
+	if _, apiErr := Observations(ctx, do, "CC", time.Date(2020, 6, 17, 0, 0, 0, 0, time.UTC)); apiErr == nil || apiErr.Code != 404 {
+		t.Errorf("expected 404 for missing fixture, got %v", apiErr)
+	}
+}
+
+// TestStaticDo_QueryFallback verifies that resources with a query fall back to the bare path.
+func TestStaticDo_QueryFallback(t *testing.T) {
+	do := StaticDo(map[string][]byte{
+		stationMetadataPath: []byte(`[{"codi":"CC","nom":"Oris"}]`),
+	})
+
+	stations, apiErr := Stations(context.Background(), do,
+		WithStationStatus(model.StationStatusOperational),
+		WithStationDate(time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC)),
+	)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(stations) != 1 || stations[0].Code != "CC" {
+		t.Errorf("unexpected stations %+v", stations)
+	}
+}
+
+// TestErrorDo verifies that ErrorDo fails every request with the given error.
+func TestErrorDo(t *testing.T) {
+	expectedError := &model.APIError{Code: 401, Message: testErrorInvalidAPIKey}
+
+	regions, apiErr := Regions(context.Background(), ErrorDo(expectedError))
+	if apiErr != expectedError {
+		t.Errorf("expected %v, got %v", expectedError, apiErr)
+	}
+	if regions != nil {
+		t.Errorf(testErrorExpectedNilRegions, regions)
+	}
+}
+
+// TestExpectOut verifies that matching targets pass through and mismatched ones name both types.
+func TestExpectOut(t *testing.T) {
+	do := ExpectOut[model.RegionList](StaticDo(map[string][]byte{
+		"/referencia/v1/comarques": []byte(`[{"codi":13,"nom":"Barcelonès"}]`),
+	}))
+	ctx := context.Background()
+
+	var regions model.RegionList
+	if apiErr := do(ctx, "GET", "/referencia/v1/comarques", &regions); apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(regions) != 1 || regions[0].Code != 13 {
+		t.Errorf("unexpected regions %+v", regions)
+	}
+
+	var stations model.StationList
+	apiErr := do(ctx, "GET", "/referencia/v1/comarques", &stations)
+	expected := "out has type *model.StationList, expected *model.RegionList"
+	if apiErr == nil || apiErr.Message != expected {
+		t.Errorf("expected %q, got %v", expected, apiErr)
+	}
+}
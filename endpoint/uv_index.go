@@ -0,0 +1,33 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const uvIndexForecastPath = "/pronostic/v1/uvi"
+
+// UVIndexForecast fetches the UV index forecast for a municipality.
+// Each forecast day carries the maximum expected UV index and its risk category.
+//
+// The municipality code must be obtained from the municipalities metadata endpoint.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - municipalityCode: the unique 6-digit identifier of the municipality (e.g., "080193")
+//
+// Returns:
+//   - model.UVIndexForecast: daily maximum UV index values with risk categories
+//   - *model.APIError: error if the request fails, municipality code is invalid, or data cannot be parsed
+func UVIndexForecast(ctx context.Context, do DoFunc, municipalityCode string) (model.UVIndexForecast, *model.APIError) {
+	resource := fmt.Sprintf("%s/%s", uvIndexForecastPath, municipalityCode)
+
+	var forecast model.UVIndexForecast
+	if err := do(ctx, "GET", resource, &forecast); err != nil {
+		return model.UVIndexForecast{}, err
+	}
+	return forecast, nil
+}
@@ -0,0 +1,77 @@
+package endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const uvIndexFixture = `{
+	"codiMunicipi": "080193",
+	"dies": [
+		{"data": "2020-08-20Z", "uviMax": 7.6},
+		{"data": "2020-08-21Z", "uviMax": 2.1},
+		{"data": "2020-08-22Z", "uviMax": 11.0}
+	]
+}`
+
+// TestUVIndexForecast_Success verifies the path and the decoded risk categories.
+func TestUVIndexForecast_Success(t *testing.T) {
+	expectedPath := "/pronostic/v1/uvi/080193"
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+
+		forecastPtr, ok := out.(*model.UVIndexForecast)
+		if !ok {
+			t.Fatalf("expected *model.UVIndexForecast, got %T", out)
+		}
+		if err := json.Unmarshal([]byte(uvIndexFixture), forecastPtr); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	forecast, apiErr := UVIndexForecast(context.Background(), mockDo, "080193")
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if forecast.MunicipalityCode != "080193" {
+		t.Errorf(testErrorExpectedMunicipalityCode, "080193", forecast.MunicipalityCode)
+	}
+
+	expected := []model.UVCategory{model.UVCategoryHigh, model.UVCategoryLow, model.UVCategoryExtreme}
+	if len(forecast.Days) != len(expected) {
+		t.Fatalf("expected %d days, got %d", len(expected), len(forecast.Days))
+	}
+	for i, want := range expected {
+		if forecast.Days[i].Risk != want {
+			t.Errorf("day %d: expected risk %q, got %q", i, want, forecast.Days[i].Risk)
+		}
+	}
+}
+
+// TestUVIndexForecast_APIError verifies that API errors are properly propagated.
+func TestUVIndexForecast_APIError(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		return &model.APIError{Code: 404, Message: "Municipality not found"}
+	}
+
+	forecast, apiErr := UVIndexForecast(context.Background(), mockDo, "999999")
+	if apiErr == nil {
+		t.Fatal(testErrorExpectedErrorNil)
+	}
+	if apiErr.Code != 404 {
+		t.Errorf("expected error code 404, got %d", apiErr.Code)
+	}
+	if forecast.MunicipalityCode != "" || forecast.Days != nil {
+		t.Errorf("expected empty forecast, got %+v", forecast)
+	}
+}
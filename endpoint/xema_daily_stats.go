@@ -0,0 +1,35 @@
+package endpoint
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const stationDailyStatsPath = "/xema/v1/estacions/resum"
+
+// DailyStats fetches the daily statistical summary of all variables measured at a station for a specific day.
+// For each variable the summary includes the daily maximum and minimum (with the time they were reached),
+// the mean and the accumulated value, when applicable.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which the summary is requested
+//
+// Returns:
+//   - model.StationDailyStats: per-variable daily aggregates for the station
+//   - *model.APIError: error if the request fails or data cannot be parsed
+func DailyStats(ctx context.Context, do DoFunc, stationCode string, date time.Time) (model.StationDailyStats, *model.APIError) {
+	utc := date.UTC()
+	resource := fmt.Sprintf("%s/%s/%04d/%02d/%02d", stationDailyStatsPath, stationCode, utc.Year(), utc.Month(), utc.Day())
+
+	var stats model.StationDailyStats
+	if err := do(ctx, "GET", resource, &stats); err != nil {
+		return model.StationDailyStats{}, err
+	}
+	return stats, nil
+}
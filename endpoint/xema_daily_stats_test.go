@@ -0,0 +1,107 @@
+package endpoint
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+const dailyStatsFixture = `{
+	"codiEstacio": "CC",
+	"data": "2020-06-16Z",
+	"variables": [
+		{
+			"codi": 32,
+			"maxim": {"valor": 25.1, "data": "2020-06-16T14:30Z"},
+			"minim": {"valor": 12.3, "data": "2020-06-16T05:00Z"},
+			"mitjana": 18.4
+		},
+		{
+			"codi": 35,
+			"acumulat": 4.2
+		}
+	]
+}`
+
+// TestDailyStats_Success verifies the dated path and unmarshaling of a representative summary.
+func TestDailyStats_Success(t *testing.T) {
+	expectedPath := "/xema/v1/estacions/resum/CC/2020/06/16"
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+
+		statsPtr, ok := out.(*model.StationDailyStats)
+		if !ok {
+			t.Fatalf("expected *model.StationDailyStats, got %T", out)
+		}
+		if err := json.Unmarshal([]byte(dailyStatsFixture), statsPtr); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	// 00:30 CEST on June 17 is still June 16 in UTC.
+	date := time.Date(2020, 6, 17, 0, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	stats, apiErr := DailyStats(context.Background(), mockDo, "CC", date)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+
+	if stats.StationCode != "CC" || stats.Date != "2020-06-16Z" {
+		t.Errorf("unexpected summary header %+v", stats)
+	}
+	if len(stats.Variables) != 2 {
+		t.Fatalf("expected 2 variables, got %d", len(stats.Variables))
+	}
+
+	temperature := stats.Variables[0]
+	if temperature.Max == nil || temperature.Max.Value != 25.1 {
+		t.Fatalf("expected max 25.1, got %+v", temperature.Max)
+	}
+	if !temperature.Max.Time.Time.Equal(time.Date(2020, 6, 16, 14, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected max time %v", temperature.Max.Time)
+	}
+	if temperature.Min == nil || temperature.Min.Value != 12.3 {
+		t.Errorf("expected min 12.3, got %+v", temperature.Min)
+	}
+	if temperature.Mean == nil || *temperature.Mean != 18.4 {
+		t.Errorf("expected mean 18.4, got %v", temperature.Mean)
+	}
+	if temperature.Accumulated != nil {
+		t.Errorf("expected no accumulated value for temperature, got %v", *temperature.Accumulated)
+	}
+
+	precipitation := stats.Variables[1]
+	if precipitation.Accumulated == nil || *precipitation.Accumulated != 4.2 {
+		t.Errorf("expected accumulated 4.2, got %v", precipitation.Accumulated)
+	}
+	if precipitation.Max != nil || precipitation.Min != nil || precipitation.Mean != nil {
+		t.Errorf("expected only the accumulated value for precipitation, got %+v", precipitation)
+	}
+}
+
+// TestDailyStats_APIError verifies that API errors are properly propagated.
+func TestDailyStats_APIError(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		return &model.APIError{Code: 404, Message: "Station not found"}
+	}
+
+	stats, apiErr := DailyStats(context.Background(), mockDo, "ZZ", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC))
+	if apiErr == nil {
+		t.Fatal(testErrorExpectedErrorNil)
+	}
+	if apiErr.Code != 404 {
+		t.Errorf("expected error code 404, got %d", apiErr.Code)
+	}
+	if stats.StationCode != "" || stats.Variables != nil {
+		t.Errorf("expected empty summary, got %+v", stats)
+	}
+}
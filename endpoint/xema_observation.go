@@ -2,17 +2,39 @@ package endpoint
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"sync"
 	"time"
 
 	"github.com/luisfrmoro/meteocat/model"
 )
 
 const (
-	stationObservationsPath = "/xema/v1/estacions/mesurades"
-	variablesMetadataPath   = "/xema/v1/variables/mesurades/metadades"
+	stationObservationsPath   = "/xema/v1/estacions/mesurades"
+	validatedObservationsPath = "/xema/v1/estacions/validades"
+	variableObservationsPath  = "/xema/v1/variables/mesurades"
+	variablesMetadataPath     = "/xema/v1/variables/mesurades/metadades"
 )
 
+// ObservationFilter holds optional filter values for station observation requests.
+type ObservationFilter struct {
+	TimeBase *model.TimeBase
+}
+
+// ObservationOption configures optional filters for station observation requests.
+type ObservationOption func(*ObservationFilter)
+
+// WithTimeBase keeps only the readings measured with the given time base (e.g., model.TimeBaseHourly).
+// The METEOCAT API has no query parameter for the time base, so this filter is applied client-side
+// after the response is decoded; variables left without readings are dropped.
+func WithTimeBase(tb model.TimeBase) ObservationOption {
+	return func(filter *ObservationFilter) {
+		filter.TimeBase = &tb
+	}
+}
+
 // Observations fetches all observations of all variables recorded at a station for a specific day.
 // The endpoint returns observation measurements grouped by variable, with each variable containing
 // a list of readings taken throughout the day.
@@ -22,24 +44,106 @@ const (
 //   - do: function to perform the actual HTTP request (typically client.do or a mock)
 //   - stationCode: the unique identifier of the station (e.g., "CC")
 //   - date: the specific date for which observations are requested
+//   - opts: optional client-side filters (e.g., WithTimeBase)
 //
 // Returns:
 //   - model.StationObservationList: list of observations with all variables and readings
 //   - *model.APIError: error if the request fails or data cannot be parsed
-func Observations(ctx context.Context, do DoFunc, stationCode string, date time.Time) (model.StationObservationList, *model.APIError) {
+func Observations(ctx context.Context, do DoFunc, stationCode string, date time.Time, opts ...ObservationOption) (model.StationObservationList, *model.APIError) {
+	return datedStationObservations(ctx, do, stationObservationsPath, stationCode, date, opts)
+}
+
+// ValidatedObservations fetches the quality-controlled observations of all variables recorded at a station
+// for a specific day. Unlike Observations, which returns data as measured, this product only contains readings
+// that have gone through METEOCAT's validation process, so it is better suited for analysis; recent days may
+// be incomplete until validation catches up. The response shares the schema of Observations.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which observations are requested
+//   - opts: optional client-side filters (e.g., WithTimeBase)
+//
+// Returns:
+//   - model.StationObservationList: list of validated observations with all variables and readings
+//   - *model.APIError: error if the request fails or data cannot be parsed
+func ValidatedObservations(ctx context.Context, do DoFunc, stationCode string, date time.Time, opts ...ObservationOption) (model.StationObservationList, *model.APIError) {
+	return datedStationObservations(ctx, do, validatedObservationsPath, stationCode, date, opts)
+}
+
+// datedStationObservations fetches the observations of a station for a day from basePath/{code}/{YYYY}/{MM}/{DD}
+// and applies the client-side filters in opts.
+func datedStationObservations(ctx context.Context, do DoFunc, basePath, stationCode string, date time.Time, opts []ObservationOption) (model.StationObservationList, *model.APIError) {
+	filter := ObservationFilter{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&filter)
+		}
+	}
+
 	year := date.UTC().Year()
 	month := date.UTC().Month()
 	day := date.UTC().Day()
 
-	resource := fmt.Sprintf("%s/%s/%04d/%02d/%02d", stationObservationsPath, stationCode, year, month, day)
+	resource := fmt.Sprintf("%s/%s/%04d/%02d/%02d", basePath, stationCode, year, month, day)
 
 	var list model.StationObservationList
 	if err := do(ctx, "GET", resource, &list); err != nil {
 		return nil, err
 	}
+	if filter.TimeBase != nil {
+		list = list.FilterTimeBase(*filter.TimeBase)
+	}
 	return list, nil
 }
 
+// StreamDoFunc abstracts a streaming HTTP call: instead of unmarshaling the whole body into a value,
+// it hands a JSON decoder positioned at the start of the response body to decode.
+// An error returned by decode aborts the request and is reported in the resulting APIError.
+type StreamDoFunc func(ctx context.Context, method, resource string, decode func(*json.Decoder) error) *model.APIError
+
+// ObservationsStream fetches the observations recorded at a station for a specific day like Observations,
+// but decodes the response array one station at a time and passes each element to fn as soon as it is parsed.
+// Returning an error from fn stops decoding.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - stream: function performing the streaming HTTP request (typically client.doStream or a mock)
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which observations are requested
+//   - fn: callback invoked for every decoded station observation
+//
+// Returns:
+//   - *model.APIError: error if the request fails, data cannot be parsed or fn returns an error
+func ObservationsStream(ctx context.Context, stream StreamDoFunc, stationCode string, date time.Time, fn func(model.StationObservation) error) *model.APIError {
+	utc := date.UTC()
+	resource := fmt.Sprintf("%s/%s/%04d/%02d/%02d", stationObservationsPath, stationCode, utc.Year(), utc.Month(), utc.Day())
+
+	return stream(ctx, "GET", resource, func(dec *json.Decoder) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("expected JSON array, got %v", tok)
+		}
+
+		for dec.More() {
+			var observation model.StationObservation
+			if err := dec.Decode(&observation); err != nil {
+				return err
+			}
+			if err := fn(observation); err != nil {
+				return err
+			}
+		}
+
+		_, err = dec.Token()
+		return err
+	})
+}
+
 // Variables fetches the metadata of all XEMA variables.
 // The endpoint returns information about all variables independently from the stations where they are measured.
 // This reference data is essential for understanding variable codes, units, decimal precision, and other properties.
@@ -58,3 +162,108 @@ func Variables(ctx context.Context, do DoFunc) (model.VariableList, *model.APIEr
 	}
 	return list, nil
 }
+
+// VariableStations fetches the stations that measure a variable, with the periods during which
+// each station measured it. It is the reverse index of the per-station variable metadata and is
+// useful to discover where a sensor (e.g., snow depth) is available.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - variableCode: the numeric code of the variable (e.g., 32 for temperature)
+//
+// Returns:
+//   - model.VariableStationList: stations measuring the variable with their state windows
+//   - *model.APIError: error if the request fails or data cannot be parsed
+func VariableStations(ctx context.Context, do DoFunc, variableCode int) (model.VariableStationList, *model.APIError) {
+	resource := fmt.Sprintf("%s/%d/metadades", variableObservationsPath, variableCode)
+
+	var list model.VariableStationList
+	if err := do(ctx, "GET", resource, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// VariableObservations fetches the observations of a single variable recorded at a station for a specific day.
+// The response uses the same structure as Observations but only contains the requested variable.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - variableCode: the numeric code of the variable (e.g., 32 for temperature)
+//   - date: the specific date for which observations are requested
+//
+// Returns:
+//   - model.StationObservationList: observations of the variable at the station
+//   - *model.APIError: error if the request fails or data cannot be parsed
+func VariableObservations(ctx context.Context, do DoFunc, stationCode string, variableCode int, date time.Time) (model.StationObservationList, *model.APIError) {
+	utc := date.UTC()
+	query := url.Values{}
+	query.Set("codiEstacio", stationCode)
+
+	resource := fmt.Sprintf("%s/%d/%04d/%02d/%02d?%s", variableObservationsPath, variableCode, utc.Year(), utc.Month(), utc.Day(), query.Encode())
+
+	var list model.StationObservationList
+	if err := do(ctx, "GET", resource, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ObservationsForVariables fetches the observations of several variables recorded at a station for a specific day.
+// The METEOCAT API has no multi-variable query, so one VariableObservations request is issued per distinct
+// variable code, concurrently, and the results are merged into a single list with StationObservationList.Merge.
+// A single variable code results in a single request; an empty variable set is rejected without any request.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - do: function to perform the actual HTTP request (typically client.do or a mock)
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - variableCodes: the numeric codes of the variables to fetch
+//   - date: the specific date for which observations are requested
+//
+// Returns:
+//   - model.StationObservationList: merged observations of the requested variables
+//   - *model.APIError: the error of the first failing variable, in the order given, or if variableCodes is empty
+func ObservationsForVariables(ctx context.Context, do DoFunc, stationCode string, variableCodes []int, date time.Time) (model.StationObservationList, *model.APIError) {
+	if len(variableCodes) == 0 {
+		return nil, &model.APIError{Message: "at least one variable code must be provided"}
+	}
+
+	codes := make([]int, 0, len(variableCodes))
+	seen := make(map[int]struct{}, len(variableCodes))
+	for _, code := range variableCodes {
+		if _, dup := seen[code]; !dup {
+			seen[code] = struct{}{}
+			codes = append(codes, code)
+		}
+	}
+
+	if len(codes) == 1 {
+		return VariableObservations(ctx, do, stationCode, codes[0], date)
+	}
+
+	results := make([]model.StationObservationList, len(codes))
+	errs := make([]*model.APIError, len(codes))
+
+	var wg sync.WaitGroup
+	for i, code := range codes {
+		wg.Add(1)
+		go func(i, code int) {
+			defer wg.Done()
+			results[i], errs[i] = VariableObservations(ctx, do, stationCode, code, date)
+		}(i, code)
+	}
+	wg.Wait()
+
+	merged := model.StationObservationList{}
+	for i := range codes {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		merged = merged.Merge(results[i])
+	}
+	return merged, nil
+}
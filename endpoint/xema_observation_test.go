@@ -3,6 +3,9 @@ package endpoint
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -456,3 +459,355 @@ func TestVariables_ContextCancellation(t *testing.T) {
 		t.Errorf(testErrorExpectedNilVariables, variables)
 	}
 }
+
+// TestVariableObservations_Path verifies that the single-variable path is built
+// from the variable code, the date and the station code.
+func TestVariableObservations_Path(t *testing.T) {
+	expectedPath := "/xema/v1/variables/mesurades/32/2020/06/16?codiEstacio=CC"
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+		*out.(*model.StationObservationList) = model.StationObservationList{}
+		return nil
+	}
+
+	testDate := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	if _, apiErr := VariableObservations(context.Background(), mockDo, "CC", 32, testDate); apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+}
+
+// TestObservations_WithTimeBase verifies that the request path is unchanged and non-matching readings are dropped.
+func TestObservations_WithTimeBase(t *testing.T) {
+	expectedPath := "/xema/v1/estacions/mesurades/CC/2020/06/16"
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+		body := `[{"codi":"CC","variables":[` +
+			`{"codi":32,"lectures":[{"data":"2020-06-16T00:00Z","valor":18.1,"baseHoraria":"HO"},{"data":"2020-06-16T00:30Z","valor":18.0,"baseHoraria":"SH"}]},` +
+			`{"codi":33,"lectures":[{"data":"2020-06-16T00:30Z","valor":71,"baseHoraria":"SH"}]}]}]`
+		if err := json.Unmarshal([]byte(body), out); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	testDate := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	list, apiErr := Observations(context.Background(), mockDo, "CC", testDate, WithTimeBase(model.TimeBaseHourly))
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(list) != 1 || len(list[0].Variables) != 1 {
+		t.Fatalf("expected only variable 32 to remain, got %+v", list)
+	}
+	if readings := list[0].Variables[0].Readings; len(readings) != 1 || readings[0].Value != 18.1 {
+		t.Errorf("expected only the hourly reading, got %+v", readings)
+	}
+
+	list, apiErr = Observations(context.Background(), mockDo, "CC", testDate)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(list[0].Variables) != 2 || len(list[0].Variables[0].Readings) != 2 {
+		t.Errorf("expected unfiltered readings without options, got %+v", list)
+	}
+}
+
+// TestVariableStations_Path verifies that the path is built from the variable code.
+func TestVariableStations_Path(t *testing.T) {
+	expectedPath := "/xema/v1/variables/mesurades/32/metadades"
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+		*out.(*model.VariableStationList) = model.VariableStationList{}
+		return nil
+	}
+
+	if _, apiErr := VariableStations(context.Background(), mockDo, 32); apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+}
+
+// TestVariableStations_Success verifies that stations and their state windows are decoded.
+func TestVariableStations_Success(t *testing.T) {
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		body := `[{"codi":"CC","estats":[{"codi":2,"dataInici":"2009-07-15T09:00Z","dataFi":null}]},` +
+			`{"codi":"D5","estats":[{"codi":2,"dataInici":"1996-01-01T00:00Z","dataFi":"2020-01-01T00:00Z"}]}]`
+		if err := json.Unmarshal([]byte(body), out); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	stations, apiErr := VariableStations(context.Background(), mockDo, 32)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(stations) != 2 || stations[0].Code != "CC" || stations[1].Code != "D5" {
+		t.Fatalf("unexpected stations %+v", stations)
+	}
+	if state := stations[0].States[0]; state.Code != 2 || state.EndDate != nil || !state.StartDate.Time.Equal(time.Date(2009, 7, 15, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected open state %+v", state)
+	}
+	if end := stations[1].States[0].EndDate; end == nil || end.Year() != 2020 {
+		t.Errorf("expected a closed state ending in 2020, got %v", end)
+	}
+}
+
+// variableObservationsDo returns a mock DoFunc serving one reading per requested variable
+// and recording the requested paths.
+func variableObservationsDo(t *testing.T, mu *sync.Mutex, paths *[]string) DoFunc {
+	return func(ctx context.Context, method, path string, out any) *model.APIError {
+		mu.Lock()
+		*paths = append(*paths, path)
+		mu.Unlock()
+
+		var code int
+		if _, err := fmt.Sscanf(path, variableObservationsPath+"/%d/", &code); err != nil {
+			t.Fatalf("parse variable code from %s: %v", path, err)
+		}
+		if code == 99 {
+			return &model.APIError{Code: 404, Message: "Variable not found"}
+		}
+
+		*out.(*model.StationObservationList) = model.StationObservationList{
+			{
+				Code: "CC",
+				Variables: []model.VariableObservation{
+					{
+						Code: code,
+						Readings: []model.Reading{
+							{Data: model.MeteocatTime{Time: time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)}, Value: float64(code), Status: "V", TimeBase: "SH"},
+						},
+					},
+				},
+			},
+		}
+		return nil
+	}
+}
+
+// TestObservationsForVariables_SingleCall verifies that one variable results in one request.
+func TestObservationsForVariables_SingleCall(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+
+	testDate := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	observations, apiErr := ObservationsForVariables(context.Background(), variableObservationsDo(t, &mu, &paths), "CC", []int{32, 32}, testDate)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+
+	if len(paths) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(paths))
+	}
+	if len(observations) != 1 || len(observations[0].Variables) != 1 || observations[0].Variables[0].Code != 32 {
+		t.Errorf("unexpected observations %+v", observations)
+	}
+}
+
+// TestObservationsForVariables_Merged verifies that several variables are fetched
+// concurrently and merged into a single station entry.
+func TestObservationsForVariables_Merged(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+
+	testDate := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	observations, apiErr := ObservationsForVariables(context.Background(), variableObservationsDo(t, &mu, &paths), "CC", []int{32, 33, 30}, testDate)
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+
+	if len(paths) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(paths))
+	}
+	if len(observations) != 1 {
+		t.Fatalf("expected 1 station, got %d", len(observations))
+	}
+	variables := observations[0].Variables
+	if len(variables) != 3 {
+		t.Fatalf("expected 3 variables, got %d", len(variables))
+	}
+	for i, code := range []int{32, 33, 30} {
+		if variables[i].Code != code {
+			t.Errorf("variable %d: expected code %d, got %d", i, code, variables[i].Code)
+		}
+	}
+}
+
+// TestObservationsForVariables_APIError verifies that a failing variable fails the whole request.
+func TestObservationsForVariables_APIError(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+
+	testDate := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	observations, apiErr := ObservationsForVariables(context.Background(), variableObservationsDo(t, &mu, &paths), "CC", []int{32, 99}, testDate)
+	if apiErr == nil {
+		t.Fatal(testErrorExpectedErrorNil)
+	}
+	if apiErr.Code != 404 {
+		t.Errorf("expected error code 404, got %d", apiErr.Code)
+	}
+	if observations != nil {
+		t.Errorf(testErrorExpectedNilObservations, observations)
+	}
+}
+
+// TestObservationsForVariables_NoVariables verifies that an empty variable set is rejected without any request.
+func TestObservationsForVariables_NoVariables(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+
+	testDate := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	for _, codes := range [][]int{nil, {}} {
+		observations, apiErr := ObservationsForVariables(context.Background(), variableObservationsDo(t, &mu, &paths), "CC", codes, testDate)
+		if apiErr == nil {
+			t.Fatal(testErrorExpectedErrorNil)
+		}
+		if observations != nil {
+			t.Errorf(testErrorExpectedNilObservations, observations)
+		}
+	}
+	if len(paths) != 0 {
+		t.Errorf("expected no requests, got %v", paths)
+	}
+}
+
+// TestValidatedObservations_DateFormatting verifies that ValidatedObservations builds the validated-data path.
+func TestValidatedObservations_DateFormatting(t *testing.T) {
+	tests := []struct {
+		name         string
+		stationCode  string
+		date         time.Time
+		expectedPath string
+	}{
+		{
+			name:         "single digit month and day",
+			stationCode:  "AB",
+			date:         time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC),
+			expectedPath: "/xema/v1/estacions/validades/AB/2020/01/05",
+		},
+		{
+			name:         "double digit month and day",
+			stationCode:  "XY",
+			date:         time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC),
+			expectedPath: "/xema/v1/estacions/validades/XY/2020/12/31",
+		},
+		{
+			name:         "leap year february",
+			stationCode:  "CD",
+			date:         time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+			expectedPath: "/xema/v1/estacions/validades/CD/2020/02/29",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+				if path != tt.expectedPath {
+					t.Errorf(testErrorExpectedPath, tt.expectedPath, path)
+				}
+				listPtr, ok := out.(*model.StationObservationList)
+				if !ok {
+					t.Fatalf(testErrorExpectedStationObservationPtr, out)
+				}
+				*listPtr = model.StationObservationList{}
+				return nil
+			}
+
+			ctx := context.Background()
+			_, apiErr := ValidatedObservations(ctx, mockDo, tt.stationCode, tt.date)
+			if apiErr != nil {
+				t.Fatalf(testErrorNoError, apiErr)
+			}
+		})
+	}
+}
+
+// TestValidatedObservations_Success verifies that ValidatedObservations parses a valid response.
+func TestValidatedObservations_Success(t *testing.T) {
+	fixture := `[{"codi":"CC","variables":[{"codi":32,"lectures":[{"data":"2020-06-16T00:00Z","valor":18.2,"estat":"V","baseHoraria":"SH"}]}]}]`
+
+	mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+		if method != "GET" {
+			t.Errorf(testErrorMethodExpected, method)
+		}
+		listPtr, ok := out.(*model.StationObservationList)
+		if !ok {
+			t.Fatalf(testErrorExpectedStationObservationPtr, out)
+		}
+		if err := json.Unmarshal([]byte(fixture), listPtr); err != nil {
+			t.Fatalf("unmarshal fixture: %v", err)
+		}
+		return nil
+	}
+
+	list, apiErr := ValidatedObservations(context.Background(), mockDo, "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC))
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(list) != 1 || list[0].Code != "CC" || len(list[0].Variables) != 1 {
+		t.Fatalf("unexpected observations %+v", list)
+	}
+	reading := list[0].Variables[0].Readings[0]
+	if reading.Value != 18.2 || reading.Status != "V" {
+		t.Errorf("unexpected reading %+v", reading)
+	}
+}
+
+// TestObservationsStream_Decode verifies the path and element-by-element decoding of the observations array.
+func TestObservationsStream_Decode(t *testing.T) {
+	body := `[{"codi":"CC","variables":[]},{"codi":"CD","variables":[]}]`
+	expectedPath := "/xema/v1/estacions/mesurades/CC/2020/06/16"
+
+	mockStream := func(ctx context.Context, method, path string, decode func(*json.Decoder) error) *model.APIError {
+		if path != expectedPath {
+			t.Errorf(testErrorExpectedPath, expectedPath, path)
+		}
+		if err := decode(json.NewDecoder(strings.NewReader(body))); err != nil {
+			return &model.APIError{Message: err.Error(), Err: err}
+		}
+		return nil
+	}
+
+	var codes []string
+	apiErr := ObservationsStream(context.Background(), mockStream, "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(obs model.StationObservation) error {
+		codes = append(codes, obs.Code)
+		return nil
+	})
+	if apiErr != nil {
+		t.Fatalf(testErrorNoError, apiErr)
+	}
+	if len(codes) != 2 || codes[0] != "CC" || codes[1] != "CD" {
+		t.Errorf("unexpected stations %v", codes)
+	}
+}
+
+// TestObservationsStream_NotArray verifies that a non-array body is rejected.
+func TestObservationsStream_NotArray(t *testing.T) {
+	mockStream := func(ctx context.Context, method, path string, decode func(*json.Decoder) error) *model.APIError {
+		if err := decode(json.NewDecoder(strings.NewReader(`{"codi":"CC"}`))); err != nil {
+			return &model.APIError{Message: err.Error(), Err: err}
+		}
+		return nil
+	}
+
+	apiErr := ObservationsStream(context.Background(), mockStream, "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(model.StationObservation) error {
+		t.Error("callback must not be called")
+		return nil
+	})
+	if apiErr == nil {
+		t.Fatal(testErrorExpectedErrorNil)
+	}
+}
@@ -12,8 +12,9 @@ const stationMetadataPath = "/xema/v1/estacions/metadades"
 
 // StationMetadataFilter holds optional filter values for station metadata requests.
 type StationMetadataFilter struct {
-	Status *model.StationStatus
-	Date   *time.Time
+	Status  *model.StationStatus
+	Date    *time.Time
+	Network *int
 }
 
 // StationMetadataOption configures optional filters for station metadata requests.
@@ -36,6 +37,15 @@ func WithStationDate(date time.Time) StationMetadataOption {
 	}
 }
 
+// WithStationNetwork keeps only stations whose Network.Code matches code.
+// The METEOCAT API has no query parameter for the network, so this filter is applied
+// client-side after the response is decoded. It composes with the status and date filters.
+func WithStationNetwork(code int) StationMetadataOption {
+	return func(filter *StationMetadataFilter) {
+		filter.Network = &code
+	}
+}
+
 // Stations fetches the list of XEMA station metadata from the METEOCAT API.
 // The endpoint can optionally filter results by operational status and date.
 //
@@ -43,15 +53,17 @@ func WithStationDate(date time.Time) StationMetadataOption {
 // both Status and Date must be provided together. They are interdependent.
 // To request all stations without filtering, provide no filters.
 // To filter by status on a specific date, provide both WithStationStatus and WithStationDate.
+// Providing only one of them returns an error without performing the request.
+// WithStationNetwork is applied client-side to the decoded list.
 //
 // Parameters:
 //   - ctx: context for cancellation and timeouts
 //   - do: function to perform the actual HTTP request (typically client.do or a mock)
-//   - opts: optional filters for status and date (both required if filtering)
+//   - opts: optional filters for status and date (both required if filtering) and network
 //
 // Returns:
 //   - model.StationList: list of station metadata
-//   - *model.APIError: error if the request fails or data cannot be parsed
+//   - *model.APIError: error if only one of status and date is provided, the request fails or data cannot be parsed
 func Stations(ctx context.Context, do DoFunc, opts ...StationMetadataOption) (model.StationList, *model.APIError) {
 	resource := stationMetadataPath
 	filter := StationMetadataFilter{}
@@ -61,12 +73,16 @@ func Stations(ctx context.Context, do DoFunc, opts ...StationMetadataOption) (mo
 		}
 	}
 
+	if (filter.Status == nil) != (filter.Date == nil) {
+		return nil, &model.APIError{Message: "status and date filters must be provided together"}
+	}
+
 	query := url.Values{}
 	if filter.Status != nil {
 		query.Set("estat", string(*filter.Status))
 	}
 	if filter.Date != nil {
-		query.Set("data", filter.Date.UTC().Format("2006-01-02Z"))
+		query.Set("data", model.NewMeteocatDate(*filter.Date).String())
 	}
 	if encoded := query.Encode(); encoded != "" {
 		resource = resource + "?" + encoded
@@ -76,5 +92,16 @@ func Stations(ctx context.Context, do DoFunc, opts ...StationMetadataOption) (mo
 	if err := do(ctx, "GET", resource, &list); err != nil {
 		return nil, err
 	}
+
+	if filter.Network != nil {
+		filtered := make(model.StationList, 0, len(list))
+		for _, station := range list {
+			if station.Network.Code == *filter.Network {
+				filtered = append(filtered, station)
+			}
+		}
+		list = filtered
+	}
+
 	return list, nil
 }
@@ -158,3 +158,104 @@ func TestStations_APIError(t *testing.T) {
 		t.Errorf(testErrorExpectedNilStations, stations)
 	}
 }
+
+// TestStations_NetworkFilter verifies that WithStationNetwork filters the decoded list
+// client-side and composes with the status and date query filters.
+func TestStations_NetworkFilter(t *testing.T) {
+	filterDate := time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC)
+	response := model.StationList{
+		{Code: "CC", Network: model.StationNetwork{Code: 1, Name: "XEMA"}},
+		{Code: "Z1", Network: model.StationNetwork{Code: 2, Name: "XAC"}},
+		{Code: "D5", Network: model.StationNetwork{Code: 1, Name: "XEMA"}},
+	}
+
+	testCases := []struct {
+		name         string
+		opts         []StationMetadataOption
+		expectedPath string
+	}{
+		{"network only", []StationMetadataOption{WithStationNetwork(1)}, stationMetadataPath},
+		{
+			"network with status and date",
+			[]StationMetadataOption{
+				WithStationStatus(model.StationStatusOperational),
+				WithStationDate(filterDate),
+				WithStationNetwork(1),
+			},
+			stationMetadataPath + "?data=2026-02-17Z&estat=ope",
+		},
+	}
+
+	for _, tc := range testCases {
+		mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+			if path != tc.expectedPath {
+				t.Errorf("%s: expected path %s, got %s", tc.name, tc.expectedPath, path)
+			}
+			*out.(*model.StationList) = append(model.StationList(nil), response...)
+			return nil
+		}
+
+		stations, apiErr := Stations(context.Background(), mockDo, tc.opts...)
+		if apiErr != nil {
+			t.Fatalf("%s: "+testErrorNoError, tc.name, apiErr)
+		}
+		if len(stations) != 2 {
+			t.Fatalf("%s: expected 2 stations, got %d", tc.name, len(stations))
+		}
+		for _, station := range stations {
+			if station.Network.Code != 1 {
+				t.Errorf("%s: expected network 1, got %d for %s", tc.name, station.Network.Code, station.Code)
+			}
+		}
+	}
+}
+
+// TestStations_FilterPairing verifies that status and date filters must be provided together
+// and that a half-filtered request is rejected before calling the API.
+func TestStations_FilterPairing(t *testing.T) {
+	filterDate := time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		opts      []StationMetadataOption
+		expectErr bool
+	}{
+		{"no filters", nil, false},
+		{"status and date", []StationMetadataOption{WithStationStatus(model.StationStatusOperational), WithStationDate(filterDate)}, false},
+		{"status only", []StationMetadataOption{WithStationStatus(model.StationStatusOperational)}, true},
+		{"date only", []StationMetadataOption{WithStationDate(filterDate)}, true},
+	}
+
+	for _, tc := range testCases {
+		called := false
+		mockDo := func(ctx context.Context, method, path string, out any) *model.APIError {
+			called = true
+			*out.(*model.StationList) = model.StationList{}
+			return nil
+		}
+
+		stations, apiErr := Stations(context.Background(), mockDo, tc.opts...)
+		if tc.expectErr {
+			if apiErr == nil {
+				t.Fatalf("%s: %s", tc.name, testErrorExpectedErrorNil)
+			}
+			if apiErr.Message != "status and date filters must be provided together" {
+				t.Errorf("%s: unexpected message %q", tc.name, apiErr.Message)
+			}
+			if called {
+				t.Errorf("%s: expected no request to be made", tc.name)
+			}
+			if stations != nil {
+				t.Errorf("%s: "+testErrorExpectedNilStations, tc.name, stations)
+			}
+			continue
+		}
+
+		if apiErr != nil {
+			t.Fatalf("%s: "+testErrorNoError, tc.name, apiErr)
+		}
+		if !called {
+			t.Errorf("%s: expected request to be made", tc.name)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package meteocat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// etagEntry holds the validator and normalized body of the last successful response for a resource.
+type etagEntry struct {
+	etag string
+	body []byte
+}
+
+// etagCache stores the last ETag and body per cache key for conditional requests.
+// It is safe for concurrent use.
+type etagCache struct {
+	mu      sync.Mutex
+	entries map[string]etagEntry
+}
+
+func newETagCache() *etagCache {
+	return &etagCache{entries: make(map[string]etagEntry)}
+}
+
+// get returns the cached entry for key, if any.
+func (e *etagCache) get(key string) (etagEntry, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	entry, ok := e.entries[key]
+	return entry, ok
+}
+
+// store records the ETag and a copy of the body for key.
+func (e *etagCache) store(key, etag string, body []byte) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.entries[key] = etagEntry{etag: etag, body: append([]byte(nil), body...)}
+}
+
+// etagResources are the reference datasets cached by WithETagCache. Other resources, such as
+// observations and forecasts, change too often to benefit and would grow the cache without bound.
+var etagResources = map[string]bool{
+	"/referencia/v1/comarques":               true,
+	"/referencia/v1/municipis":               true,
+	"/referencia/v1/simbols":                 true,
+	"/xema/v1/variables/mesurades/metadades": true,
+}
+
+// WithETagCache enables conditional requests for the reference datasets (regions, municipalities,
+// symbols and variable metadata), which rarely change.
+// The client remembers the last ETag and body per resource and sends If-None-Match on the next request;
+// when the API answers 304 Not Modified, the previously cached result is decoded into the output
+// instead of reporting an error. Entries are kept per WithAPIKeyOverride key and WithLanguage
+// setting, and the cache is shared with clients created by Clone.
+func WithETagCache() ClientOption {
+	return func(c *Client) error {
+		c.etagCache = newETagCache()
+		return nil
+	}
+}
+
+// etagKey returns the cache key of resource for a request made with ctx, or "" when resource is
+// not cached. The body depends on the API key and language, so both are part of the key.
+func (c *Client) etagKey(ctx context.Context, resource string) string {
+	if c.etagCache == nil || !etagResources[resource] {
+		return ""
+	}
+	return resource + "\x00" + apiKeyOverride(ctx) + "\x00" + c.language
+}
+
+// applyETag adds If-None-Match to req when a cached entry exists for resource.
+func (c *Client) applyETag(req *http.Request, resource string) {
+	key := c.etagKey(req.Context(), resource)
+	if key == "" || req.Method != http.MethodGet {
+		return
+	}
+	if entry, ok := c.etagCache.get(key); ok {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+}
+
+// handleNotModified decodes the cached body for resource into out after a 304 response and returns it.
+// It reports false when there is no cached entry to serve.
+func (c *Client) handleNotModified(ctx context.Context, resp *http.Response, resource string, out any) ([]byte, bool, *model.APIError) {
+	key := c.etagKey(ctx, resource)
+	if key == "" || resp.StatusCode != http.StatusNotModified {
+		return nil, false, nil
+	}
+	entry, ok := c.etagCache.get(key)
+	if !ok {
+		return nil, false, nil
+	}
+	if err := json.Unmarshal(entry.body, out); err != nil {
+		return nil, true, &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("unmarshal cached response: %v", err)}
+	}
+	return entry.body, true, nil
+}
+
+// storeETag remembers the body of a successful GET response that carries an ETag.
+func (c *Client) storeETag(ctx context.Context, resp *http.Response, resource string, body []byte) {
+	key := c.etagKey(ctx, resource)
+	if key == "" || resp.Request == nil || resp.Request.Method != http.MethodGet {
+		return
+	}
+	if etag := resp.Header.Get("ETag"); etag != "" && len(body) > 0 {
+		c.etagCache.store(key, etag, body)
+	}
+}
@@ -0,0 +1,116 @@
+package meteocat
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestWithETagCache_NotModified verifies that a 304 after a 200 returns the cached result
+// and that If-None-Match carries the stored ETag.
+func TestWithETagCache_NotModified(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			if inm := req.Header.Get("If-None-Match"); inm != "" {
+				t.Errorf("expected no If-None-Match on first request, got %q", inm)
+			}
+			resp := newTestResponse(req, http.StatusOK, "application/json", `[{"codi":1,"nom":"Alt Camp"},{"codi":2,"nom":"Alt Empordà"}]`)
+			resp.Header.Set("ETag", `"v1"`)
+			return resp, nil
+		}
+
+		if inm := req.Header.Get("If-None-Match"); inm != `"v1"` {
+			t.Errorf("expected If-None-Match \"v1\", got %q", inm)
+		}
+		return newTestResponse(req, http.StatusNotModified, "", ""), nil
+	}, WithETagCache())
+
+	ctx := context.Background()
+	first, apiErr := client.Regions(ctx)
+	if apiErr != nil {
+		t.Fatalf("first request: %v", apiErr)
+	}
+
+	second, apiErr := client.Regions(ctx)
+	if apiErr != nil {
+		t.Fatalf("second request: %v", apiErr)
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 requests, got %d", calls)
+	}
+	if len(second) != len(first) || len(second) != 2 {
+		t.Fatalf("expected 2 cached regions, got %d", len(second))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Errorf("region %d: expected %+v, got %+v", i, first[i], second[i])
+		}
+	}
+}
+
+// TestWithETagCache_Disabled verifies that a 304 without the cache is reported as an error.
+func TestWithETagCache_Disabled(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if inm := req.Header.Get("If-None-Match"); inm != "" {
+			t.Errorf("expected no If-None-Match, got %q", inm)
+		}
+		return newTestResponse(req, http.StatusNotModified, "", ""), nil
+	})
+
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil || apiErr.Code != http.StatusNotModified {
+		t.Fatalf("expected 304 error, got %v", apiErr)
+	}
+}
+
+// TestWithETagCache_ReferenceOnly verifies that only reference datasets are cached.
+func TestWithETagCache_ReferenceOnly(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		if inm := req.Header.Get("If-None-Match"); inm != "" {
+			t.Errorf("expected no If-None-Match for %s, got %q", req.URL.Path, inm)
+		}
+		resp := newTestResponse(req, http.StatusOK, "application/json", `[]`)
+		resp.Header.Set("ETag", `"v1"`)
+		return resp, nil
+	}, WithETagCache())
+
+	date := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		if _, apiErr := client.Observations(context.Background(), "CC", date); apiErr != nil {
+			t.Fatalf("unexpected error: %v", apiErr)
+		}
+	}
+	if n := len(client.etagCache.entries); n != 0 {
+		t.Errorf("expected no cached entries, got %d", n)
+	}
+}
+
+// TestWithETagCache_Key verifies that entries are not shared across API key overrides or languages.
+func TestWithETagCache_Key(t *testing.T) {
+	var inm []string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		inm = append(inm, req.Header.Get("If-None-Match"))
+		resp := newTestResponse(req, http.StatusOK, "application/json", `[{"codi":1,"nom":"Alt Camp"}]`)
+		resp.Header.Set("ETag", `"v1"`)
+		return resp, nil
+	}, WithETagCache())
+	spanish, err := client.Clone(WithLanguage("es"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := context.Background()
+	client.Regions(ctx)
+	client.Regions(WithAPIKeyOverride(ctx, "tenant-b-key"))
+	spanish.Regions(ctx)
+	client.Regions(ctx)
+
+	expected := []string{"", "", "", `"v1"`}
+	if !slices.Equal(inm, expected) {
+		t.Errorf("expected If-None-Match %q, got %q", expected, inm)
+	}
+}
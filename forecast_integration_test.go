@@ -103,7 +103,7 @@ func validateForecastStructure(t *testing.T, forecast MunicipalityHourlyForecast
 func validateForecastDayIntegration(t *testing.T, dayIndex int, day ForecastDay) {
 	t.Helper()
 
-	if strings.TrimSpace(day.Date) == "" {
+	if day.Date.IsZero() {
 		t.Fatalf("day %d: expected Date to be set", dayIndex)
 	}
 
@@ -0,0 +1,85 @@
+package meteocat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// RequestMetrics describes a completed API call, as reported to a RequestMetricsFunc.
+type RequestMetrics struct {
+	// Method is the HTTP method (e.g., "GET")
+	Method string
+
+	// Endpoint is the resource with its variable parts removed, suitable as a low-cardinality
+	// metrics label (e.g., "/xema/v1/estacions/mesurades" for any station and date)
+	Endpoint string
+
+	// StatusClass is the class of the HTTP status ("2xx", "3xx", "4xx", "5xx"), or "error"
+	// when no response was received
+	StatusClass string
+
+	// Duration is the time taken by the call, including retries
+	Duration time.Duration
+
+	// Err is the error returned to the caller, or nil on success
+	Err *model.APIError
+}
+
+// RequestMetricsFunc receives the metrics of every completed API call.
+type RequestMetricsFunc func(m RequestMetrics)
+
+// WithRequestMetrics registers a callback invoked once per API call, after retries, with its endpoint,
+// status class, duration and error. It is the integration point for metrics systems such as Prometheus:
+// the callback typically increments request and error counters and observes a latency histogram
+// labeled by Endpoint and StatusClass. The callback runs on the calling goroutine and must be safe
+// for concurrent use.
+func WithRequestMetrics(fn RequestMetricsFunc) ClientOption {
+	return func(c *Client) error {
+		c.requestMetrics = fn
+		return nil
+	}
+}
+
+// recordRequest reports a call started at start to the WithRequestMetrics callback, if any.
+// The status of a failed call is taken from apiErr, so failures without a response count as "error".
+func (c *Client) recordRequest(method, resource string, start time.Time, status int, apiErr *model.APIError) {
+	if c.requestMetrics == nil {
+		return
+	}
+	if apiErr != nil {
+		status = apiErr.Code
+	}
+	c.requestMetrics(RequestMetrics{
+		Method:      method,
+		Endpoint:    endpointLabel(resource),
+		StatusClass: statusClass(status),
+		Duration:    time.Since(start),
+		Err:         apiErr,
+	})
+}
+
+// endpointLabel strips the query and the variable path segments from resource: the path is cut at the
+// first segment without lowercase letters, such as a date, a municipality code or a station code.
+func endpointLabel(resource string) string {
+	path, _, _ := strings.Cut(resource, "?")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if strings.IndexFunc(segment, unicode.IsLower) < 0 {
+			segments = segments[:i]
+			break
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// statusClass returns the class of an HTTP status code (e.g., "4xx"), or "error" when there is none.
+func statusClass(status int) string {
+	if status < 100 || status > 599 {
+		return "error"
+	}
+	return fmt.Sprintf("%dxx", status/100)
+}
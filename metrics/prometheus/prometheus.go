@@ -0,0 +1,94 @@
+// Package prometheus exports METEOCAT client metrics to Prometheus.
+//
+// It lives in its own module so that the core library keeps no third-party dependencies;
+// it is built on top of meteocat.WithRequestMetrics.
+package prometheus
+
+import (
+	"errors"
+
+	"github.com/luisfrmoro/meteocat"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// Collectors holds the Prometheus collectors updated by WithMetrics.
+type Collectors struct {
+	// Requests counts completed API calls by method, endpoint and status class
+	// (meteocat_requests_total).
+	Requests *prom.CounterVec
+
+	// Errors counts failed API calls by method, endpoint and status class
+	// (meteocat_request_errors_total).
+	Errors *prom.CounterVec
+
+	// Latency observes the duration of API calls, including retries, by method and endpoint
+	// (meteocat_request_duration_seconds).
+	Latency *prom.HistogramVec
+}
+
+// NewCollectors creates the collectors and registers them with reg. Collectors already registered
+// with reg (e.g., by another client sharing the registry) are reused.
+func NewCollectors(reg prom.Registerer) (*Collectors, error) {
+	requests := prom.NewCounterVec(prom.CounterOpts{
+		Name: "meteocat_requests_total",
+		Help: "Completed METEOCAT API calls.",
+	}, []string{"method", "endpoint", "status_class"})
+	errs := prom.NewCounterVec(prom.CounterOpts{
+		Name: "meteocat_request_errors_total",
+		Help: "Failed METEOCAT API calls.",
+	}, []string{"method", "endpoint", "status_class"})
+	latency := prom.NewHistogramVec(prom.HistogramOpts{
+		Name:    "meteocat_request_duration_seconds",
+		Help:    "Duration of METEOCAT API calls, including retries.",
+		Buckets: prom.DefBuckets,
+	}, []string{"method", "endpoint"})
+
+	var err error
+	if requests, err = register(reg, requests); err != nil {
+		return nil, err
+	}
+	if errs, err = register(reg, errs); err != nil {
+		return nil, err
+	}
+	if latency, err = register(reg, latency); err != nil {
+		return nil, err
+	}
+	return &Collectors{Requests: requests, Errors: errs, Latency: latency}, nil
+}
+
+// Observe records the metrics of a completed API call.
+func (c *Collectors) Observe(m meteocat.RequestMetrics) {
+	c.Requests.WithLabelValues(m.Method, m.Endpoint, m.StatusClass).Inc()
+	if m.Err != nil {
+		c.Errors.WithLabelValues(m.Method, m.Endpoint, m.StatusClass).Inc()
+	}
+	c.Latency.WithLabelValues(m.Method, m.Endpoint).Observe(m.Duration.Seconds())
+}
+
+// WithMetrics registers request-count, error-count and latency-histogram collectors with reg
+// (see Collectors) and updates them on every API call. The client fails to build if registration fails.
+// It installs the client's meteocat.WithRequestMetrics callback; the last of the two options wins.
+func WithMetrics(reg prom.Registerer) meteocat.ClientOption {
+	return func(c *meteocat.Client) error {
+		collectors, err := NewCollectors(reg)
+		if err != nil {
+			return err
+		}
+		return meteocat.WithRequestMetrics(collectors.Observe)(c)
+	}
+}
+
+// register registers collector with reg, returning the existing collector when an identical one is
+// already registered.
+func register[C prom.Collector](reg prom.Registerer, collector C) (C, error) {
+	if err := reg.Register(collector); err != nil {
+		var already prom.AlreadyRegisteredError
+		if errors.As(err, &already) {
+			if existing, ok := already.ExistingCollector.(C); ok {
+				return existing, nil
+			}
+		}
+		return collector, err
+	}
+	return collector, nil
+}
@@ -0,0 +1,98 @@
+package prometheus
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/luisfrmoro/meteocat"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// roundTripFunc adapts a function into an http.RoundTripper.
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (fn roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+// newTestClient builds a client answering 200 for the regions list and 404 for anything else.
+func newTestClient(t *testing.T, opts ...meteocat.ClientOption) *meteocat.Client {
+	t.Helper()
+
+	fn := func(req *http.Request) (*http.Response, error) {
+		status, body := http.StatusNotFound, `{"message":"not found"}`
+		if req.URL.Path == "/referencia/v1/comarques" {
+			status, body = http.StatusOK, `[{"codi":13,"nom":"Barcelonès"}]`
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}, nil
+	}
+	client, err := meteocat.NewClient("test-api-key", &http.Client{Transport: roundTripFunc(fn)}, opts...)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	return client
+}
+
+// TestWithMetrics verifies the request, error and latency collectors against a real registry.
+func TestWithMetrics(t *testing.T) {
+	reg := prom.NewRegistry()
+	client := newTestClient(t, WithMetrics(reg))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, apiErr := client.Regions(ctx); apiErr != nil {
+			t.Fatalf("unexpected error: %v", apiErr)
+		}
+	}
+	if _, apiErr := client.MunicipalHourlyForecast(ctx, "250019"); apiErr == nil {
+		t.Fatal("expected a 404 error")
+	}
+
+	collectors, err := NewCollectors(reg)
+	if err != nil {
+		t.Fatalf("expected existing collectors to be reused, got %v", err)
+	}
+	if got := testutil.ToFloat64(collectors.Requests.WithLabelValues("GET", "/referencia/v1/comarques", "2xx")); got != 2 {
+		t.Errorf("expected 2 successful region requests, got %v", got)
+	}
+	if got := testutil.ToFloat64(collectors.Requests.WithLabelValues("GET", "/pronostic/v1/municipalHoraria", "4xx")); got != 1 {
+		t.Errorf("expected 1 forecast request, got %v", got)
+	}
+	if got := testutil.ToFloat64(collectors.Errors.WithLabelValues("GET", "/pronostic/v1/municipalHoraria", "4xx")); got != 1 {
+		t.Errorf("expected 1 forecast error, got %v", got)
+	}
+	if got := testutil.CollectAndCount(collectors.Errors); got != 1 {
+		t.Errorf("expected only the failed endpoint in the error counter, got %d series", got)
+	}
+	if got := testutil.CollectAndCount(collectors.Latency, "meteocat_request_duration_seconds"); got != 2 {
+		t.Errorf("expected a latency histogram per endpoint, got %d", got)
+	}
+
+	// A second client on the same registry shares the collectors.
+	other := newTestClient(t, WithMetrics(reg))
+	if _, apiErr := other.Regions(ctx); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if got := testutil.ToFloat64(collectors.Requests.WithLabelValues("GET", "/referencia/v1/comarques", "2xx")); got != 3 {
+		t.Errorf("expected 3 region requests across clients, got %v", got)
+	}
+}
+
+// TestWithMetrics_RegistrationConflict verifies that a conflicting collector fails client creation.
+func TestWithMetrics_RegistrationConflict(t *testing.T) {
+	reg := prom.NewRegistry()
+	reg.MustRegister(prom.NewCounter(prom.CounterOpts{Name: "meteocat_requests_total", Help: "conflict"}))
+
+	if _, err := meteocat.NewClient("test-api-key", nil, WithMetrics(reg)); err == nil {
+		t.Error("expected a registration error")
+	}
+}
@@ -0,0 +1,81 @@
+package meteocat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testRegistry collects request and error counts per endpoint and status class, like a metrics registry.
+type testRegistry struct {
+	mu       sync.Mutex
+	requests map[string]int
+	errors   map[string]int
+}
+
+func (r *testRegistry) observe(m RequestMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := m.Method + " " + m.Endpoint + " " + m.StatusClass
+	r.requests[key]++
+	if m.Err != nil {
+		r.errors[key]++
+	}
+}
+
+// TestWithRequestMetrics verifies that every call is reported once with its endpoint and status class.
+func TestWithRequestMetrics(t *testing.T) {
+	registry := &testRegistry{requests: map[string]int{}, errors: map[string]int{}}
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/referencia/v1/comarques":
+			return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+		case "/xema/v1/estacions/mesurades/CC/2020/06/16":
+			return newTestResponse(req, http.StatusServiceUnavailable, "application/json", `{"message":"unavailable"}`), nil
+		default:
+			return nil, errors.New("connection refused")
+		}
+	}, WithRequestMetrics(registry.observe), WithRetry(2, 0))
+
+	ctx := context.Background()
+	client.Regions(ctx)
+	client.Regions(ctx)
+	client.Observations(ctx, "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC))
+	client.Symbols(ctx)
+
+	expected := map[string]int{
+		"GET /referencia/v1/comarques 2xx":     2,
+		"GET /xema/v1/estacions/mesurades 5xx": 1,
+		"GET /referencia/v1/simbols error":     1,
+	}
+	for key, count := range expected {
+		if registry.requests[key] != count {
+			t.Errorf("%s: expected %d requests, got %d", key, count, registry.requests[key])
+		}
+	}
+	if len(registry.requests) != len(expected) {
+		t.Errorf("unexpected series %v", registry.requests)
+	}
+	if registry.errors["GET /referencia/v1/comarques 2xx"] != 0 || registry.errors["GET /xema/v1/estacions/mesurades 5xx"] != 1 {
+		t.Errorf("unexpected error counts %v", registry.errors)
+	}
+}
+
+// TestEndpointLabel verifies that codes, dates and queries are removed from resources.
+func TestEndpointLabel(t *testing.T) {
+	testCases := map[string]string{
+		"/referencia/v1/comarques":                           "/referencia/v1/comarques",
+		"/xema/v1/estacions/mesurades/CC/2020/06/16":         "/xema/v1/estacions/mesurades",
+		"/xema/v1/variables/mesurades/32/2020/06/16?codi=CC": "/xema/v1/variables/mesurades",
+		"/pronostic/v1/municipalHoraria/080193":              "/pronostic/v1/municipalHoraria",
+		"/xema/v1/estacions/metadades?estat=ope":             "/xema/v1/estacions/metadades",
+	}
+	for resource, expected := range testCases {
+		if got := endpointLabel(resource); got != expected {
+			t.Errorf("%s: expected %s, got %s", resource, expected, got)
+		}
+	}
+}
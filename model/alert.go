@@ -0,0 +1,82 @@
+package model
+
+import "time"
+
+// AlertSeverity is the warning level of a meteorological alert.
+type AlertSeverity string
+
+const (
+	// AlertSeverityYellow is the lowest warning level (groc).
+	AlertSeverityYellow AlertSeverity = "groc"
+
+	// AlertSeverityOrange is the intermediate warning level (taronja).
+	AlertSeverityOrange AlertSeverity = "taronja"
+
+	// AlertSeverityRed is the highest warning level (vermell).
+	AlertSeverityRed AlertSeverity = "vermell"
+)
+
+// Level returns the rank of the severity: 1 for yellow, 2 for orange and 3 for red.
+// Unknown severities rank 0, below every known level.
+func (s AlertSeverity) Level() int {
+	switch s {
+	case AlertSeverityYellow:
+		return 1
+	case AlertSeverityOrange:
+		return 2
+	case AlertSeverityRed:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Alert represents a meteorological warning for a region over a validity window.
+type Alert struct {
+	// Region is the region (comarca) the alert applies to
+	Region Region `json:"comarca"`
+
+	// Severity is the warning level of the alert
+	Severity AlertSeverity `json:"nivell"`
+
+	// Start is the beginning of the validity window in UTC
+	Start MeteocatTime `json:"dataInici"`
+
+	// End is the end of the validity window in UTC
+	End MeteocatTime `json:"dataFi"`
+
+	// Description is the textual description of the alert in Catalan
+	Description string `json:"descripcio"`
+}
+
+// Active reports whether at falls in the alert's half-open validity window [Start, End).
+func (a Alert) Active(at time.Time) bool {
+	return !at.Before(a.Start.Time) && at.Before(a.End.Time)
+}
+
+// AlertList represents a collection of alerts.
+type AlertList []Alert
+
+// HighestFor returns the most severe alert for the region identified by regionCode that is active at at
+// (ordered red > orange > yellow). Ties are broken by the earliest Start. It reports false when no alert
+// for the region is active. The returned alert is a copy.
+func (l AlertList) HighestFor(regionCode int, at time.Time) (*Alert, bool) {
+	var highest *Alert
+	for i := range l {
+		a := &l[i]
+		if a.Region.Code != regionCode || !a.Active(at) {
+			continue
+		}
+		if highest == nil ||
+			a.Severity.Level() > highest.Severity.Level() ||
+			a.Severity.Level() == highest.Severity.Level() && a.Start.Time.Before(highest.Start.Time) {
+			highest = a
+		}
+	}
+	if highest == nil {
+		return nil, false
+	}
+
+	alert := *highest
+	return &alert, true
+}
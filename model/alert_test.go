@@ -0,0 +1,55 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// newTestAlert builds an alert for region active between the given hours of 2020-08-20 UTC.
+func newTestAlert(region int, severity AlertSeverity, startHour, endHour int) Alert {
+	return Alert{
+		Region:   Region{Code: region},
+		Severity: severity,
+		Start:    NewMeteocatTime(time.Date(2020, 8, 20, startHour, 0, 0, 0, time.UTC)),
+		End:      NewMeteocatTime(time.Date(2020, 8, 20, endHour, 0, 0, 0, time.UTC)),
+	}
+}
+
+// TestAlertListHighestFor verifies severity ordering, region and window filtering and tie breaking.
+func TestAlertListHighestFor(t *testing.T) {
+	alerts := AlertList{
+		newTestAlert(13, AlertSeverityYellow, 6, 22),
+		newTestAlert(13, AlertSeverityOrange, 12, 18),
+		newTestAlert(13, AlertSeverityRed, 19, 21),
+		newTestAlert(14, AlertSeverityRed, 0, 23),
+		newTestAlert(13, AlertSeverityOrange, 10, 16),
+	}
+
+	testCases := []struct {
+		hour     int
+		expected AlertSeverity
+		start    int
+	}{
+		{8, AlertSeverityYellow, 6},
+		{13, AlertSeverityOrange, 10},
+		{17, AlertSeverityOrange, 12},
+		{20, AlertSeverityRed, 19},
+	}
+
+	for _, tc := range testCases {
+		alert, ok := alerts.HighestFor(13, time.Date(2020, 8, 20, tc.hour, 0, 0, 0, time.UTC))
+		if !ok {
+			t.Fatalf("%02d:00: expected an active alert", tc.hour)
+		}
+		if alert.Severity != tc.expected || alert.Start.Hour() != tc.start {
+			t.Errorf("%02d:00: expected %s alert starting at %02d:00, got %s starting at %v", tc.hour, tc.expected, tc.start, alert.Severity, alert.Start)
+		}
+	}
+
+	if alert, ok := alerts.HighestFor(13, time.Date(2020, 8, 20, 22, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("expected no alert at the end of every window, got %+v", alert)
+	}
+	if alert, ok := alerts.HighestFor(15, time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC)); ok {
+		t.Errorf("expected no alert for another region, got %+v", alert)
+	}
+}
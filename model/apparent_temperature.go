@@ -0,0 +1,76 @@
+package model
+
+import (
+	"math"
+	"strconv"
+)
+
+// Thresholds of the apparent temperature model used by ApparentTemp.
+const (
+	windChillMaxTempC   = 10.0
+	windChillMinWindKmh = 4.8
+	heatIndexMinTempC   = 27.0
+)
+
+// ApparentTemp returns the "feels like" temperature in °C for an air temperature in °C, a wind speed
+// in km/h and a relative humidity in percent:
+//   - at or below 10 °C with wind above 4.8 km/h, the wind chill index (Environment Canada / NWS 2001 formula);
+//   - at or above 27 °C, the heat index (NWS Rothfusz regression, computed in °F and converted back);
+//   - otherwise, the air temperature itself.
+func ApparentTemp(tempC, windKmh, humidityPct float64) float64 {
+	switch {
+	case tempC <= windChillMaxTempC && windKmh > windChillMinWindKmh:
+		v := math.Pow(windKmh, 0.16)
+		return 13.12 + 0.6215*tempC - 11.37*v + 0.3965*tempC*v
+	case tempC >= heatIndexMinTempC:
+		t := tempC*9/5 + 32
+		rh := humidityPct
+		hi := -42.379 + 2.04901523*t + 10.14333127*rh - 0.22475541*t*rh -
+			0.00683783*t*t - 0.05481717*rh*rh + 0.00122874*t*t*rh +
+			0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+		return (hi - 32) * 5 / 9
+	default:
+		return tempC
+	}
+}
+
+// ComputedApparentTemperature derives hourly apparent temperatures with ApparentTemp from the day's
+// temperature, wind speed and humidity values, for forecasts that omit tempXafogor. Values are matched
+// by hour; hours lacking a numeric value for any of the three variables are skipped. Results are
+// rounded to one decimal, like the API's temperatures. It reports false when any of the variables is absent.
+func (d ForecastDay) ComputedApparentTemperature() ([]HourlyValue, bool) {
+	v := d.Variables
+	if v == nil || v.Temperature == nil || v.WindSpeed == nil || v.Humidity == nil {
+		return nil, false
+	}
+
+	wind := numericByTime(v.WindSpeed.Values)
+	humidity := numericByTime(v.Humidity.Values)
+
+	out := make([]HourlyValue, 0, len(v.Temperature.Values))
+	for _, t := range v.Temperature.Values {
+		temp, err := t.Value.Float64()
+		if err != nil {
+			continue
+		}
+		w, okWind := wind[t.Time.Unix()]
+		h, okHumidity := humidity[t.Time.Unix()]
+		if !okWind || !okHumidity {
+			continue
+		}
+		apparent := math.Round(ApparentTemp(temp, w, h)*10) / 10
+		out = append(out, HourlyValue{Value: StringOrFloat64(strconv.FormatFloat(apparent, 'f', 1, 64)), Time: t.Time})
+	}
+	return out, true
+}
+
+// numericByTime indexes the numeric values by Unix timestamp, skipping missing and non-numeric values.
+func numericByTime(values []HourlyValue) map[int64]float64 {
+	out := make(map[int64]float64, len(values))
+	for _, v := range values {
+		if f, err := v.Value.Float64(); err == nil {
+			out[v.Time.Unix()] = f
+		}
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestApparentTemp verifies the formula regimes against published wind chill and heat index tables.
+func TestApparentTemp(t *testing.T) {
+	testCases := []struct {
+		name     string
+		temp     float64
+		wind     float64
+		humidity float64
+		expected float64
+	}{
+		// Environment Canada wind chill table: -10 °C at 20 km/h feels like -18.
+		{"wind chill", -10, 20, 50, -17.9},
+		{"calm cold", 5, 3, 50, 5},
+		{"mild", 20, 30, 90, 20},
+		// NWS heat index table: 90 °F (32.2 °C) at 70% feels like 106 °F (41.1 °C).
+		{"heat index", (90.0 - 32) * 5 / 9, 10, 70, 41.1},
+		// NWS heat index table: 100 °F (37.8 °C) at 40% feels like 109 °F (42.9 °C).
+		{"dry heat", (100.0 - 32) * 5 / 9, 10, 40, 42.9},
+	}
+
+	for _, tc := range testCases {
+		if got := ApparentTemp(tc.temp, tc.wind, tc.humidity); math.Abs(got-tc.expected) > 0.1 {
+			t.Errorf("%s: expected %.1f, got %.2f", tc.name, tc.expected, got)
+		}
+	}
+}
+
+// TestForecastDayComputedApparentTemperature verifies hour matching and the missing variable case.
+func TestForecastDayComputedApparentTemperature(t *testing.T) {
+	at := func(hour int) MeteocatTime {
+		return MeteocatTime{Time: time.Date(2020, 1, 20, hour, 0, 0, 0, time.UTC)}
+	}
+	day := ForecastDay{
+		Date: mustParseDate("2020-01-20Z"),
+		Variables: &ForecastVariables{
+			Temperature: &Temperature{Values: []HourlyValue{{Value: "-10", Time: at(0)}, {Value: "15", Time: at(1)}, {Value: "12", Time: at(2)}}},
+			WindSpeed:   &WindSpeed{Values: []HourlyValue{{Value: "20", Time: at(0)}, {Value: "10", Time: at(1)}}},
+			Humidity:    &Humidity{Values: []HourlyValue{{Value: "50", Time: at(0)}, {Value: "60", Time: at(1)}, {Value: "70", Time: at(2)}}},
+		},
+	}
+
+	values, ok := day.ComputedApparentTemperature()
+	if !ok {
+		t.Fatal("expected computed values")
+	}
+	if len(values) != 2 || values[0].Value != "-17.9" || values[1].Value != "15.0" || values[1].Time.Hour() != 1 {
+		t.Errorf("unexpected values %+v", values)
+	}
+
+	day.Variables.Humidity = nil
+	if _, ok := day.ComputedApparentTemperature(); ok {
+		t.Error("expected ok=false without humidity")
+	}
+}
@@ -0,0 +1,108 @@
+package model
+
+// SeaState represents the sea state as a degree of the Douglas sea scale (0-9),
+// the scale METEOCAT uses in its maritime forecasts.
+type SeaState int
+
+const (
+	// SeaStateCalmGlassy is degree 0 (calma): no waves.
+	SeaStateCalmGlassy SeaState = 0
+
+	// SeaStateCalmRippled is degree 1 (arrissada): wave height up to 0.1 m.
+	SeaStateCalmRippled SeaState = 1
+
+	// SeaStateSmooth is degree 2 (marejol): wave height between 0.1 and 0.5 m.
+	SeaStateSmooth SeaState = 2
+
+	// SeaStateSlight is degree 3 (maror): wave height between 0.5 and 1.25 m.
+	SeaStateSlight SeaState = 3
+
+	// SeaStateModerate is degree 4 (forta maror): wave height between 1.25 and 2.5 m.
+	SeaStateModerate SeaState = 4
+
+	// SeaStateRough is degree 5 (maregassa): wave height between 2.5 and 4 m.
+	SeaStateRough SeaState = 5
+
+	// SeaStateVeryRough is degree 6 (mar brava): wave height between 4 and 6 m.
+	SeaStateVeryRough SeaState = 6
+
+	// SeaStateHigh is degree 7 (mar molt brava): wave height between 6 and 9 m.
+	SeaStateHigh SeaState = 7
+
+	// SeaStateVeryHigh is degree 8 (mar desfeta): wave height between 9 and 14 m.
+	SeaStateVeryHigh SeaState = 8
+
+	// SeaStatePhenomenal is degree 9 (mar enorme): wave height above 14 m.
+	SeaStatePhenomenal SeaState = 9
+)
+
+// seaStateNames holds the Catalan names of the Douglas sea scale degrees.
+var seaStateNames = [...]string{
+	"calma",
+	"arrissada",
+	"marejol",
+	"maror",
+	"forta maror",
+	"maregassa",
+	"mar brava",
+	"mar molt brava",
+	"mar desfeta",
+	"mar enorme",
+}
+
+// String returns the Catalan name of the sea state, or "desconegut" for degrees outside the scale.
+func (s SeaState) String() string {
+	if s < SeaStateCalmGlassy || s > SeaStatePhenomenal {
+		return "desconegut"
+	}
+	return seaStateNames[s]
+}
+
+// CoastalPeriod represents the maritime conditions forecast for a coastal zone during a validity period.
+type CoastalPeriod struct {
+	// ValidFrom is the start of the validity period in UTC
+	ValidFrom MeteocatTime `json:"dataInici"`
+
+	// ValidTo is the end of the validity period in UTC
+	ValidTo MeteocatTime `json:"dataFi"`
+
+	// SeaState is the expected sea state on the Douglas scale
+	SeaState SeaState `json:"estatMar"`
+
+	// WaveHeightMin is the minimum expected significant wave height in meters
+	WaveHeightMin float64 `json:"alturaOnaMin"`
+
+	// WaveHeightMax is the maximum expected significant wave height in meters
+	WaveHeightMax float64 `json:"alturaOnaMax"`
+
+	// WindDirection is the expected wind direction over water as a compass point (e.g., "N", "SW")
+	WindDirection string `json:"direccioVent"`
+
+	// WindSpeedMin is the minimum expected wind speed over water in km/h
+	WindSpeedMin float64 `json:"velocitatVentMin"`
+
+	// WindSpeedMax is the maximum expected wind speed over water in km/h
+	WindSpeedMax float64 `json:"velocitatVentMax"`
+}
+
+// CoastalZoneForecast groups the forecast periods of a single coastal zone.
+type CoastalZoneForecast struct {
+	// Code is the unique identifier of the coastal zone
+	Code string `json:"codi"`
+
+	// Name is the official name of the coastal zone (e.g., "Costa Brava")
+	Name string `json:"nom"`
+
+	// Periods lists the forecast conditions for each validity period
+	Periods []CoastalPeriod `json:"periodes"`
+}
+
+// CoastalForecast represents the maritime forecast for the Catalan coast,
+// split into coastal zones, each with its own validity periods.
+type CoastalForecast struct {
+	// IssuedAt is the time the forecast was published in UTC
+	IssuedAt MeteocatTime `json:"dataPublicacio"`
+
+	// Zones contains the forecast for each coastal zone
+	Zones []CoastalZoneForecast `json:"zones"`
+}
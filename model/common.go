@@ -3,9 +3,15 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// earthRadiusKm is the mean Earth radius used for great-circle distances.
+const earthRadiusKm = 6371.0
+
 // Coordinates represents geographic coordinates in decimal format.
 // Latitude and Longitude are expressed in decimal degrees as per the
 // World Geodetic System (WGS84).
@@ -17,12 +23,47 @@ type Coordinates struct {
 	Longitude float64 `json:"longitud"`
 }
 
+// Valid reports whether the coordinates are within the WGS84 range:
+// latitude in [-90, 90] and longitude in [-180, 180]. NaN values are never valid.
+func (c Coordinates) Valid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 &&
+		c.Longitude >= -180 && c.Longitude <= 180
+}
+
+// IsZero reports whether both latitude and longitude are zero, which the API uses
+// (and Go defaults to) when coordinates are not set.
+func (c Coordinates) IsZero() bool {
+	return c.Latitude == 0 && c.Longitude == 0
+}
+
+// DistanceKm returns the great-circle (haversine) distance in kilometers between c and other.
+func (c Coordinates) DistanceKm(other Coordinates) float64 {
+	lat1 := c.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - c.Longitude) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
 // MeteocatTime parses time strings that may omit seconds (e.g., 1992-05-11T15:30Z).
 // It marshals back to RFC3339 for stability in tests and consumers.
 type MeteocatTime struct {
 	time.Time
 }
 
+// NewMeteocatTime wraps t as a MeteocatTime normalized to UTC, with any monotonic clock reading stripped,
+// so values built from different locations compare and print consistently.
+func NewMeteocatTime(t time.Time) MeteocatTime {
+	return MeteocatTime{Time: t.Round(0).UTC()}
+}
+
+// Equal reports whether m and other represent the same instant, regardless of location.
+func (m MeteocatTime) Equal(other MeteocatTime) bool {
+	return m.Time.Equal(other.Time)
+}
+
 // UnmarshalJSON supports multiple METEOCAT time layouts, including values without seconds.
 func (m *MeteocatTime) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
@@ -51,10 +92,106 @@ func (m *MeteocatTime) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("parse time %q", raw)
 }
 
-// MarshalJSON writes the timestamp using RFC3339 format.
+// MarshalJSON writes the timestamp in UTC using RFC3339 format.
 func (m MeteocatTime) MarshalJSON() ([]byte, error) {
 	if m.Time.IsZero() {
 		return []byte("null"), nil
 	}
-	return json.Marshal(m.Time.Format(time.RFC3339))
+	return json.Marshal(m.Time.UTC().Format(time.RFC3339))
+}
+
+// meteocatDateLayout is the date-only layout used by the API (e.g., "2020-08-20Z"), both in payloads
+// such as ForecastDay.Date and in the "data" query parameter.
+const meteocatDateLayout = "2006-01-02Z"
+
+// MeteocatDate is a date-only value, held as midnight UTC of the day.
+// It is encoded as "2006-01-02Z" (e.g., "2020-08-20Z"), the form used by the API for dates.
+type MeteocatDate struct {
+	time.Time
+}
+
+// NewMeteocatDate returns the date of t in UTC as a MeteocatDate.
+func NewMeteocatDate(t time.Time) MeteocatDate {
+	t = t.UTC()
+	return MeteocatDate{Time: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+// ParseMeteocatDate parses a date in the API form "2006-01-02Z"; the trailing "Z" is optional.
+func ParseMeteocatDate(s string) (MeteocatDate, error) {
+	raw := strings.TrimSpace(s)
+	parsed, err := time.Parse(meteocatDateLayout, raw)
+	if err != nil {
+		parsed, err = time.Parse(time.DateOnly, raw)
+	}
+	if err != nil {
+		return MeteocatDate{}, fmt.Errorf("parse date %q", s)
+	}
+	return MeteocatDate{Time: parsed}, nil
+}
+
+// String returns the date in the API form (e.g., "2020-08-20Z"), or "" for the zero value.
+func (d MeteocatDate) String() string {
+	if d.Time.IsZero() {
+		return ""
+	}
+	return d.Time.UTC().Format(meteocatDateLayout)
+}
+
+// UnmarshalJSON parses a date-only string (see ParseMeteocatDate). An empty string decodes as the
+// zero date, so a day without a date does not fail the whole payload; check it with IsZero.
+// JSON null leaves the value unchanged.
+func (d *MeteocatDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(raw) == "" {
+		*d = MeteocatDate{}
+		return nil
+	}
+	parsed, err := ParseMeteocatDate(raw)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalJSON writes the date in the API form (e.g., "2020-08-20Z"), or null for the zero value.
+func (d MeteocatDate) MarshalJSON() ([]byte, error) {
+	if d.Time.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+// IntOrString decodes integer codes that the API sends either as JSON numbers (24) or as
+// strings holding a number ("24"). It marshals as a JSON number.
+type IntOrString int
+
+// UnmarshalJSON accepts an integer number or a string containing one. JSON null leaves the value unchanged.
+func (i *IntOrString) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("parse integer code %q: %w", s, err)
+		}
+		*i = IntOrString(n)
+		return nil
+	}
+
+	var n *int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("parse integer code %s: %w", data, err)
+	}
+	if n != nil {
+		*i = IntOrString(*n)
+	}
+	return nil
 }
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+)
+
+// TestCoordinatesValid verifies range validation and the zero check for coordinates.
+func TestCoordinatesValid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		coords Coordinates
+		valid  bool
+		zero   bool
+	}{
+		{"barcelona", Coordinates{Latitude: 41.3874, Longitude: 2.1686}, true, false},
+		{"zero", Coordinates{}, true, true},
+		{"north pole", Coordinates{Latitude: 90, Longitude: 0}, true, false},
+		{"antimeridian", Coordinates{Latitude: -45, Longitude: -180}, true, false},
+		{"latitude too high", Coordinates{Latitude: 90.1, Longitude: 2}, false, false},
+		{"latitude too low", Coordinates{Latitude: -91, Longitude: 2}, false, false},
+		{"longitude too high", Coordinates{Latitude: 41, Longitude: 180.5}, false, false},
+		{"swapped", Coordinates{Latitude: 2.1686, Longitude: 241.3874}, false, false},
+		{"nan", Coordinates{Latitude: math.NaN(), Longitude: 2}, false, false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.coords.Valid(); got != tc.valid {
+			t.Errorf("%s: expected Valid() %t, got %t", tc.name, tc.valid, got)
+		}
+		if got := tc.coords.IsZero(); got != tc.zero {
+			t.Errorf("%s: expected IsZero() %t, got %t", tc.name, tc.zero, got)
+		}
+	}
+}
+
+// TestCoordinatesDistanceKm verifies the haversine distance against a known value and symmetry.
+func TestCoordinatesDistanceKm(t *testing.T) {
+	barcelona := Coordinates{Latitude: 41.3874, Longitude: 2.1686}
+	girona := Coordinates{Latitude: 41.9794, Longitude: 2.8214}
+
+	if d := barcelona.DistanceKm(girona); math.Abs(d-85.28) > 0.1 {
+		t.Errorf("expected about 85.28 km, got %.2f", d)
+	}
+	if barcelona.DistanceKm(girona) != girona.DistanceKm(barcelona) {
+		t.Error("expected a symmetric distance")
+	}
+	if d := barcelona.DistanceKm(barcelona); d != 0 {
+		t.Errorf("expected zero distance to itself, got %v", d)
+	}
+}
+
+// TestNewMeteocatTime verifies normalization to UTC and instant-based equality.
+func TestNewMeteocatTime(t *testing.T) {
+	madrid := time.FixedZone("CEST", 2*60*60)
+	local := NewMeteocatTime(time.Date(2020, 6, 16, 2, 30, 0, 0, madrid))
+	utc := NewMeteocatTime(time.Date(2020, 6, 16, 0, 30, 0, 0, time.UTC))
+
+	if !local.Equal(utc) {
+		t.Errorf("expected %v to equal %v", local, utc)
+	}
+	if local.Location() != time.UTC {
+		t.Errorf("expected UTC location, got %v", local.Location())
+	}
+	if local.Equal(NewMeteocatTime(utc.Add(time.Minute))) {
+		t.Error("expected different instants not to be equal")
+	}
+
+	now := NewMeteocatTime(time.Now())
+	if now.Time != now.Round(0) {
+		t.Error("expected monotonic clock reading to be stripped")
+	}
+}
+
+// TestMeteocatTimeMarshalJSON verifies that timestamps are emitted as RFC3339 UTC.
+func TestMeteocatTimeMarshalJSON(t *testing.T) {
+	madrid := time.FixedZone("CEST", 2*60*60)
+	value := MeteocatTime{Time: time.Date(2020, 6, 16, 2, 30, 0, 0, madrid)}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(data) != `"2020-06-16T00:30:00Z"` {
+		t.Errorf("expected \"2020-06-16T00:30:00Z\", got %s", data)
+	}
+}
+
+// mustParseDate parses a date in the API form for test fixtures, panicking on error.
+func mustParseDate(s string) MeteocatDate {
+	date, err := ParseMeteocatDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return date
+}
+
+// TestMeteocatDateRoundTrip verifies that date-only values and datetimes keep their own encodings.
+func TestMeteocatDateRoundTrip(t *testing.T) {
+	var value struct {
+		Date MeteocatDate `json:"data"`
+		Time MeteocatTime `json:"hora"`
+	}
+	input := `{"data":"2020-08-20Z","hora":"2020-08-20T15:30:00Z"}`
+
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !value.Date.Time.Equal(time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)) || value.Date.String() != "2020-08-20Z" {
+		t.Errorf("unexpected date %v", value.Date.Time)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(data) != input {
+		t.Errorf("expected %s, got %s", input, data)
+	}
+}
+
+// TestMeteocatDateParse verifies accepted layouts, normalization to UTC dates and rejection of datetimes.
+func TestMeteocatDateParse(t *testing.T) {
+	for _, s := range []string{"2020-08-20Z", "2020-08-20"} {
+		date, err := ParseMeteocatDate(s)
+		if err != nil || date.String() != "2020-08-20Z" {
+			t.Errorf("%q: expected 2020-08-20Z, got %q (%v)", s, date, err)
+		}
+	}
+	var empty MeteocatDate
+	if err := json.Unmarshal([]byte(`""`), &empty); err != nil || !empty.IsZero() {
+		t.Errorf("expected an empty string to decode as the zero date, got %v (%v)", empty, err)
+	}
+	if _, err := ParseMeteocatDate("2020-08-20T10:00Z"); err == nil {
+		t.Error("expected error for a datetime")
+	}
+
+	madrid := time.FixedZone("CEST", 2*60*60)
+	if got := NewMeteocatDate(time.Date(2020, 8, 21, 1, 0, 0, 0, madrid)).String(); got != "2020-08-20Z" {
+		t.Errorf("expected the UTC date 2020-08-20Z, got %s", got)
+	}
+
+	if data, _ := json.Marshal(MeteocatDate{}); string(data) != "null" {
+		t.Errorf("expected null for the zero date, got %s", data)
+	}
+}
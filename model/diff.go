@@ -0,0 +1,64 @@
+package model
+
+import "reflect"
+
+// Diff compares a previous region list (the receiver) with a newer one, matching regions by Code.
+// It returns the regions only present in b, those only present in a, and the regions of b whose
+// name differs from the region with the same code in a. Results follow the order of their source list.
+func (a RegionList) Diff(b RegionList) (added, removed, changed RegionList) {
+	return diffByCode(a, b, func(r Region) int { return r.Code }, func(x, y Region) bool { return x == y })
+}
+
+// Diff compares a previous municipality list (the receiver) with a newer one, matching municipalities by Code.
+// It returns the municipalities only present in b, those only present in a, and the municipalities of b whose
+// name, coordinates or region differ from the municipality with the same code in a.
+// Results follow the order of their source list.
+func (a MunicipalityList) Diff(b MunicipalityList) (added, removed, changed MunicipalityList) {
+	return diffByCode(a, b, func(m Municipality) string { return m.Code }, func(x, y Municipality) bool {
+		return x.Name == y.Name && equalPtr(x.Coordinates, y.Coordinates) && equalPtr(x.Region, y.Region)
+	})
+}
+
+// Diff compares a previous station list (the receiver) with a newer one, matching stations by Code.
+// It returns the stations only present in b, those only present in a, and the stations of b whose
+// metadata (any field, including the state history) differs from the station with the same code in a.
+// Results follow the order of their source list.
+func (a StationList) Diff(b StationList) (added, removed, changed StationList) {
+	return diffByCode(a, b, func(s Station) string { return s.Code }, func(x, y Station) bool { return reflect.DeepEqual(x, y) })
+}
+
+// diffByCode implements the Diff methods. When a list repeats a code, the last entry wins.
+func diffByCode[S ~[]E, E any, K comparable](a, b S, code func(E) K, equal func(x, y E) bool) (added, removed, changed S) {
+	previous := make(map[K]E, len(a))
+	for _, e := range a {
+		previous[code(e)] = e
+	}
+	current := make(map[K]struct{}, len(b))
+
+	for _, e := range b {
+		k := code(e)
+		current[k] = struct{}{}
+		old, ok := previous[k]
+		switch {
+		case !ok:
+			added = append(added, e)
+		case !equal(old, e):
+			changed = append(changed, e)
+		}
+	}
+
+	for _, e := range a {
+		if _, ok := current[code(e)]; !ok {
+			removed = append(removed, e)
+		}
+	}
+	return added, removed, changed
+}
+
+// equalPtr reports whether x and y are both nil or point to equal values.
+func equalPtr[T comparable](x, y *T) bool {
+	if x == nil || y == nil {
+		return x == y
+	}
+	return *x == *y
+}
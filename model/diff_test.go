@@ -0,0 +1,57 @@
+package model
+
+import "testing"
+
+// TestMunicipalityListDiff verifies additions, removals and a coordinate change.
+func TestMunicipalityListDiff(t *testing.T) {
+	barcelona := &Region{Code: 13, Name: "Barcelonès"}
+	previous := MunicipalityList{
+		{Code: "080193", Name: "Barcelona", Coordinates: &Coordinates{Latitude: 41.3874, Longitude: 2.1686}, Region: barcelona},
+		{Code: "081017", Name: "l'Hospitalet de Llobregat", Coordinates: &Coordinates{Latitude: 41.3596, Longitude: 2.0997}, Region: barcelona},
+		{Code: "082009", Name: "Sant Adrià de Besòs", Region: barcelona},
+	}
+	current := MunicipalityList{
+		{Code: "080193", Name: "Barcelona", Coordinates: &Coordinates{Latitude: 41.3874, Longitude: 2.1686}, Region: &Region{Code: 13, Name: "Barcelonès"}},
+		{Code: "081017", Name: "l'Hospitalet de Llobregat", Coordinates: &Coordinates{Latitude: 41.36, Longitude: 2.1}, Region: barcelona},
+		{Code: "170792", Name: "Girona"},
+	}
+
+	added, removed, changed := previous.Diff(current)
+	if len(added) != 1 || added[0].Code != "170792" {
+		t.Errorf("expected Girona to be added, got %+v", added)
+	}
+	if len(removed) != 1 || removed[0].Code != "082009" {
+		t.Errorf("expected Sant Adrià de Besòs to be removed, got %+v", removed)
+	}
+	if len(changed) != 1 || changed[0].Code != "081017" || changed[0].Coordinates.Latitude != 41.36 {
+		t.Errorf("expected the new coordinates of l'Hospitalet to be reported, got %+v", changed)
+	}
+
+	if added, removed, changed := current.Diff(current); added != nil || removed != nil || changed != nil {
+		t.Errorf("expected no differences with itself, got %v %v %v", added, removed, changed)
+	}
+}
+
+// TestRegionListDiff verifies additions, removals and a renamed region.
+func TestRegionListDiff(t *testing.T) {
+	previous := RegionList{{Code: 13, Name: "Barcelonès"}, {Code: 14, Name: "Bergueda"}, {Code: 15, Name: "Cerdanya"}}
+	current := RegionList{{Code: 13, Name: "Barcelonès"}, {Code: 14, Name: "Berguedà"}, {Code: 43, Name: "Lluçanès"}}
+
+	added, removed, changed := previous.Diff(current)
+	if len(added) != 1 || added[0].Code != 43 || len(removed) != 1 || removed[0].Code != 15 || len(changed) != 1 || changed[0].Name != "Berguedà" {
+		t.Errorf("unexpected diff: added %v, removed %v, changed %v", added, removed, changed)
+	}
+}
+
+// TestStationListDiff verifies that any metadata change, including the state history, is reported.
+func TestStationListDiff(t *testing.T) {
+	previous := newTestStations()
+	current := newTestStations()[1:]
+	current[0].States = []StationState{{Code: 2}}
+	current = append(current, Station{Code: "WU", Name: "Badalona - Museu"})
+
+	added, removed, changed := previous.Diff(current)
+	if stationCodes(added) != "WU" || stationCodes(removed) != "D5" || stationCodes(changed) != "CC" {
+		t.Errorf("unexpected diff: added %s, removed %s, changed %s", stationCodes(added), stationCodes(removed), stationCodes(changed))
+	}
+}
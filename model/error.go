@@ -1,12 +1,79 @@
 package model
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnsupportedCharset is matched (via errors.Is) by errors caused by a response declaring
+// a charset the client cannot decode.
+var ErrUnsupportedCharset = errors.New("unsupported charset")
+
+// ErrInvalidResult is matched (via errors.Is) by errors caused by a result validator rejecting
+// a successfully decoded response.
+var ErrInvalidResult = errors.New("invalid result")
+
+// ErrEmptyReference is matched (via errors.Is) by errors reporting that the API returned an empty
+// reference list where data was expected.
+var ErrEmptyReference = errors.New("empty reference list")
+
+// ErrUnauthorized is matched (via errors.Is) by errors reporting that the API rejected the API key
+// (HTTP 401 or 403).
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrUnreachable is matched (via errors.Is) by errors reporting that no response was received from
+// the API, such as DNS, connection or TLS failures.
+var ErrUnreachable = errors.New("api unreachable")
+
 // APIError represents an error returned by the METEOCAT API or encountered while performing a request.
 // When no HTTP response was received, the Code field will be zero.
 type APIError struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
+
+	// Method and Path identify the request that failed (e.g., "GET" and "/xema/v1/estacions/metadades").
+	// The client sets them on every error it returns; they are empty for errors built elsewhere.
+	Method string `json:"-"`
+	Path   string `json:"-"`
+
+	// Err is the optional underlying cause, exposed through Unwrap for errors.Is and errors.As.
+	Err error `json:"-"`
 }
 
+// Error formats the error as "<method> <path>: <code> <message>" (e.g., "GET /xema/v1/...: 404 Station not found").
+// The request prefix is omitted when Method and Path are empty, and the code when it is zero, so an
+// APIError holding only a Message formats as the message alone.
 func (e *APIError) Error() string {
-	return e.Message
+	msg := e.Message
+	if e.Code != 0 && (e.Method != "" || e.Path != "") {
+		msg = fmt.Sprintf("%d %s", e.Code, e.Message)
+	}
+
+	request := strings.TrimSpace(e.Method + " " + e.Path)
+	if request == "" {
+		return msg
+	}
+	return request + ": " + msg
+}
+
+// Unwrap returns the underlying cause of the error, if any.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// CharsetError reports a response whose declared charset cannot be decoded.
+// It matches ErrUnsupportedCharset with errors.Is.
+type CharsetError struct {
+	// Charset is the offending charset name as declared in the Content-Type header
+	Charset string
+}
+
+func (e *CharsetError) Error() string {
+	return fmt.Sprintf("unsupported charset %q", e.Charset)
+}
+
+// Is reports whether target is ErrUnsupportedCharset.
+func (e *CharsetError) Is(target error) bool {
+	return target == ErrUnsupportedCharset
 }
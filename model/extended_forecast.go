@@ -0,0 +1,64 @@
+package model
+
+// DailyValue is a single forecast value for a whole day with its unit.
+type DailyValue struct {
+	// Unit is the unit of measurement (e.g., "°C" or "%")
+	Unit string `json:"unitat"`
+
+	// Value is the forecast value; the API may return it as either a string or a number
+	Value StringOrFloat64 `json:"valor"`
+}
+
+// ExtendedForecastVariables holds the daily variables of an extended municipal forecast.
+// Variables the API omits are nil.
+type ExtendedForecastVariables struct {
+	// MaxTemperature is the maximum temperature of the day (tmax)
+	MaxTemperature *DailyValue `json:"tmax,omitempty"`
+
+	// MinTemperature is the minimum temperature of the day (tmin)
+	MinTemperature *DailyValue `json:"tmin,omitempty"`
+
+	// PrecipitationProbability is the probability of precipitation during the day, in percent
+	PrecipitationProbability *DailyValue `json:"precipitacio,omitempty"`
+
+	// SkyCode is the sky state symbol code of the day, resolvable with the symbols metadata endpoint
+	SkyCode *DailyValue `json:"estatCel,omitempty"`
+}
+
+// ExtendedForecastDay is the forecast of a single day in an extended municipal forecast.
+type ExtendedForecastDay struct {
+	// Date is the forecast day, sent by the API as "YYYY-MM-DDZ" (e.g., "2020-08-20Z").
+	// Its String method returns that form.
+	Date MeteocatDate `json:"data"`
+
+	// Variables holds the daily variables for this day
+	Variables *ExtendedForecastVariables `json:"variables"`
+}
+
+// TemperatureRange returns the day's minimum and maximum temperatures.
+// It reports false when either value is missing or non-numeric.
+func (d ExtendedForecastDay) TemperatureRange() (min, max float64, ok bool) {
+	if d.Variables == nil || d.Variables.MinTemperature == nil || d.Variables.MaxTemperature == nil {
+		return 0, 0, false
+	}
+
+	min, err := d.Variables.MinTemperature.Value.Float64()
+	if err != nil {
+		return 0, 0, false
+	}
+	max, err = d.Variables.MaxTemperature.Value.Float64()
+	if err != nil {
+		return 0, 0, false
+	}
+	return min, max, true
+}
+
+// MunicipalityExtendedForecast is the daily forecast of a municipality over a horizon longer than
+// the 72-hour hourly forecast (typically 8 days).
+type MunicipalityExtendedForecast struct {
+	// MunicipalityCode is the unique 6-digit identifier for the municipality (e.g., "080193")
+	MunicipalityCode string `json:"codiMunicipi"`
+
+	// Days contains the forecast for each day
+	Days []ExtendedForecastDay `json:"dies"`
+}
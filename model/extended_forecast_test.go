@@ -0,0 +1,27 @@
+package model
+
+import "testing"
+
+// TestExtendedForecastDayTemperatureRange verifies the range of a complete day and the missing cases.
+func TestExtendedForecastDayTemperatureRange(t *testing.T) {
+	day := ExtendedForecastDay{
+		Date: mustParseDate("2020-08-20Z"),
+		Variables: &ExtendedForecastVariables{
+			MaxTemperature: &DailyValue{Unit: "°C", Value: "30.1"},
+			MinTemperature: &DailyValue{Unit: "°C", Value: "21.4"},
+		},
+	}
+
+	min, max, ok := day.TemperatureRange()
+	if !ok || min != 21.4 || max != 30.1 {
+		t.Errorf("expected (21.4, 30.1, true), got (%v, %v, %t)", min, max, ok)
+	}
+
+	day.Variables.MinTemperature.Value = "n/a"
+	if _, _, ok := day.TemperatureRange(); ok {
+		t.Error("expected no range with a non-numeric minimum")
+	}
+	if _, _, ok := (ExtendedForecastDay{}).TemperatureRange(); ok {
+		t.Error("expected no range without variables")
+	}
+}
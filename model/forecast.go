@@ -3,6 +3,11 @@ package model
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // HourlyValue represents a single meteorological measurement at a specific hour.
@@ -14,15 +19,27 @@ type HourlyValue struct {
 
 	// Time is the timestamp (in UTC) of the measurement
 	Time MeteocatTime `json:"data"`
+
+	// Interpolated is true for values computed by the library (see Temperature.Interpolated)
+	// rather than sent by the API. It is never set when decoding API responses.
+	Interpolated bool `json:"-"`
+}
+
+// IsMissing reports whether the API sent no value for this hour (an absent, null or empty value),
+// as opposed to a real reading such as "0.0". Aggregation helpers skip missing values.
+func (v HourlyValue) IsMissing() bool {
+	return strings.TrimSpace(string(v.Value)) == ""
 }
 
 // StringOrFloat64 handles JSON values that may be either strings or numbers
 type StringOrFloat64 string
 
-// UnmarshalJSON unmarshals a string or number into StringOrFloat64
+// UnmarshalJSON unmarshals a string or number into StringOrFloat64.
+// Empty input, JSON null and "" all decode to the empty value, which HourlyValue.IsMissing reports
+// as missing rather than as a zero reading.
 func (s *StringOrFloat64) UnmarshalJSON(data []byte) error {
 	if len(data) == 0 {
-		*s = "0"
+		*s = ""
 		return nil
 	}
 
@@ -50,6 +67,27 @@ func (s StringOrFloat64) MarshalJSON() ([]byte, error) {
 	return json.Marshal(string(s))
 }
 
+// Float64 parses the value as a floating-point number.
+func (s StringOrFloat64) Float64() (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(string(s)), 64)
+}
+
+// SymbolCode returns the value as a symbol code comparable with SymbolValue.Code.
+// Integral numeric values are normalized to their integer form ("3.0" becomes "3"),
+// while non-numeric codes (e.g., "3a") are returned unchanged.
+func (s StringOrFloat64) SymbolCode() string {
+	f, err := s.Float64()
+	if err != nil || math.IsInf(f, 0) || f != math.Trunc(f) {
+		return string(s)
+	}
+	return strconv.FormatFloat(f, 'f', 0, 64)
+}
+
+// InvalidValueFunc is called by aggregation helpers such as ForecastDay.TotalPrecipitationWithHook for
+// every value they treat as zero because it is non-numeric or out of range (e.g., negative precipitation).
+// The variable argument is the API name of the variable (e.g., "precipitacio").
+type InvalidValueFunc func(variable string, value HourlyValue)
+
 // Temperature represents hourly temperature forecasts in Celsius
 type Temperature struct {
 	Unit   string        `json:"unitat"`
@@ -68,12 +106,33 @@ type Humidity struct {
 	Values []HourlyValue `json:"valors"`
 }
 
-// Precipitation represents hourly precipitation forecasts in millimeters
+// Precipitation represents hourly precipitation forecasts in millimeters.
+// Unlike the other variables, the METEOCAT API documents precipitation values under "valor"
+// instead of "valors"; both keys are accepted when unmarshaling.
 type Precipitation struct {
 	Unit   string        `json:"unitat"`
 	Values []HourlyValue `json:"valor"`
 }
 
+// UnmarshalJSON decodes precipitation values from either the "valor" or the "valors" key.
+func (p *Precipitation) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Unit   string        `json:"unitat"`
+		Valor  []HourlyValue `json:"valor"`
+		Valors []HourlyValue `json:"valors"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	p.Unit = raw.Unit
+	p.Values = raw.Valor
+	if p.Values == nil {
+		p.Values = raw.Valors
+	}
+	return nil
+}
+
 // WindSpeed represents hourly wind speed forecasts in km/h
 type WindSpeed struct {
 	Unit   string        `json:"unitat"`
@@ -120,13 +179,129 @@ type ForecastVariables struct {
 // ForecastDay represents all forecast data for a single day.
 // It contains the date and all meteorological variables available for that day.
 type ForecastDay struct {
-	// Date is the date of the forecast in format "YYYY-MM-DDTZ" (e.g., "2020-08-20Z")
-	Date string `json:"data"`
+	// Date is the date of the forecast, sent by the API as "YYYY-MM-DDZ" (e.g., "2020-08-20Z").
+	// Its String method returns that form.
+	Date MeteocatDate `json:"data"`
 
 	// Variables holds all meteorological variables available for this forecast day
 	Variables *ForecastVariables `json:"variables"`
 }
 
+// ParsedDate returns Date as midnight UTC of that day, or an error when the date is missing.
+// Date already holds the parsed value; ParsedDate is kept for code written when Date was a string.
+func (d ForecastDay) ParsedDate() (time.Time, error) {
+	if d.Date.IsZero() {
+		return time.Time{}, fmt.Errorf("forecast date is missing")
+	}
+	return d.Date.Time, nil
+}
+
+// TotalPrecipitation sums the day's hourly precipitation values.
+// It returns the total, the unit reported by the API and ok=false when the day has no precipitation data.
+// Missing values (see HourlyValue.IsMissing) are skipped. Other negative or non-numeric values count
+// as zero; use TotalPrecipitationWithHook to be told about them.
+func (d ForecastDay) TotalPrecipitation() (float64, string, bool) {
+	return d.TotalPrecipitationWithHook(nil)
+}
+
+// TotalPrecipitationWithHook behaves like TotalPrecipitation and also calls onInvalid, when not nil,
+// for every negative or non-numeric value counted as zero.
+func (d ForecastDay) TotalPrecipitationWithHook(onInvalid InvalidValueFunc) (float64, string, bool) {
+	if d.Variables == nil || d.Variables.Precipitation == nil {
+		return 0, "", false
+	}
+
+	precipitation := d.Variables.Precipitation
+	total := 0.0
+	for _, v := range precipitation.Values {
+		if v.IsMissing() {
+			continue
+		}
+		amount, err := v.Value.Float64()
+		if err != nil || amount < 0 {
+			if onInvalid != nil {
+				onInvalid("precipitacio", v)
+			}
+			continue
+		}
+		total += amount
+	}
+
+	return total, precipitation.Unit, true
+}
+
+// Bounds (inclusive, local Catalan hours) of the daytime used to break ties in DominantSkyCode.
+const (
+	daytimeStartHour = 9
+	daytimeEndHour   = 18
+)
+
+// Fixed zones for Catalan local time (Europe/Madrid), built in rather than loaded from the system
+// time zone database, which may be missing.
+var (
+	centralEuropeanTime       = time.FixedZone("CET", 1*60*60)
+	centralEuropeanSummerTime = time.FixedZone("CEST", 2*60*60)
+)
+
+// catalanTime returns t in Catalan local time, applying the EU summer time rule: CEST from 01:00 UTC
+// on the last Sunday of March to 01:00 UTC on the last Sunday of October, CET otherwise.
+func catalanTime(t time.Time) time.Time {
+	t = t.UTC()
+	start := lastSunday(t.Year(), time.March).Add(time.Hour)
+	end := lastSunday(t.Year(), time.October).Add(time.Hour)
+	if !t.Before(start) && t.Before(end) {
+		return t.In(centralEuropeanSummerTime)
+	}
+	return t.In(centralEuropeanTime)
+}
+
+// lastSunday returns midnight UTC of the last Sunday of month in year.
+func lastSunday(year int, month time.Month) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+	return last.AddDate(0, 0, -int(last.Weekday()))
+}
+
+// DominantSkyCode returns the most frequent sky state ("estatCel") symbol code of the day, e.g. for
+// a single summary icon. Codes are normalized with StringOrFloat64.SymbolCode and missing values are
+// skipped. Ties are broken by the number of occurrences during daytime, 09:00 to 18:00 inclusive in
+// Catalan local time (CET/CEST, as Europe/Madrid), then by the earliest occurrence. It reports false
+// when the day has no sky values.
+func (d ForecastDay) DominantSkyCode() (string, bool) {
+	if d.Variables == nil || d.Variables.SkyConditions == nil {
+		return "", false
+	}
+
+	type tally struct {
+		total, daytime, first int
+	}
+	tallies := make(map[string]*tally)
+	for i, v := range d.Variables.SkyConditions.Values {
+		if v.IsMissing() {
+			continue
+		}
+		code := v.Value.SymbolCode()
+		t, ok := tallies[code]
+		if !ok {
+			t = &tally{first: i}
+			tallies[code] = t
+		}
+		t.total++
+		if hour := catalanTime(v.Time.Time).Hour(); hour >= daytimeStartHour && hour <= daytimeEndHour {
+			t.daytime++
+		}
+	}
+
+	var dominant string
+	var best *tally
+	for code, t := range tallies {
+		if best == nil || t.total > best.total ||
+			(t.total == best.total && (t.daytime > best.daytime || (t.daytime == best.daytime && t.first < best.first))) {
+			dominant, best = code, t
+		}
+	}
+	return dominant, best != nil
+}
+
 // MunicipalityHourlyForecast represents a complete 72-hour hourly forecast for a single municipality.
 // The forecast is updated twice daily (approximately at 5 AM and 5 PM) and provides
 // hourly predictions for temperature, precipitation, humidity, wind speed/direction,
@@ -139,4 +314,396 @@ type MunicipalityHourlyForecast struct {
 	// Days contains the forecast data for each day in the 72-hour window
 	// Typically contains 3 days of data with hourly resolution
 	Days []ForecastDay `json:"dies"`
+
+	// IssuedAt is the time the forecast was generated, when the API provides it.
+	// Current payloads usually omit it, in which case it is nil.
+	IssuedAt *MeteocatTime `json:"dataPrediccio,omitempty"`
+}
+
+// IsStale reports whether the forecast is older than maxAge at now.
+// When IssuedAt is present, the age is measured from it. Otherwise the age is derived from the
+// earliest parseable day (midnight UTC). Forecasts are issued during their first day (around 05:00
+// and 17:00), so this age is a conservative upper bound and may report a forecast as stale early.
+// A forecast without an issue time and without any parseable day is always stale.
+func (f MunicipalityHourlyForecast) IsStale(now time.Time, maxAge time.Duration) bool {
+	if f.IssuedAt != nil && !f.IssuedAt.IsZero() {
+		return now.Sub(f.IssuedAt.Time) > maxAge
+	}
+
+	var earliest time.Time
+	for _, day := range f.Days {
+		date, err := day.ParsedDate()
+		if err != nil {
+			continue
+		}
+		if earliest.IsZero() || date.Before(earliest) {
+			earliest = date
+		}
+	}
+	if earliest.IsZero() {
+		return true
+	}
+
+	return now.Sub(earliest) > maxAge
+}
+
+// ReferencedSymbolCodes returns the distinct sky state ("estatCel") symbol codes used by the forecast,
+// in order of first appearance. Codes are normalized (see StringOrFloat64.SymbolCode), so "3" and
+// "3.0" count once, and missing values are skipped.
+func (f MunicipalityHourlyForecast) ReferencedSymbolCodes() []string {
+	var codes []string
+	seen := make(map[string]bool)
+	for _, day := range f.Days {
+		if day.Variables == nil || day.Variables.SkyConditions == nil {
+			continue
+		}
+		for _, v := range day.Variables.SkyConditions.Values {
+			if v.IsMissing() {
+				continue
+			}
+			code := v.Value.SymbolCode()
+			if !seen[code] {
+				seen[code] = true
+				codes = append(codes, code)
+			}
+		}
+	}
+	return codes
+}
+
+// Window returns the UTC period covered by the forecast days: start is midnight of the first day
+// and end is midnight after the last day, so end is exclusive. It reports false when there are no
+// days or the first or last Date is missing.
+func (f MunicipalityHourlyForecast) Window() (start, end time.Time, ok bool) {
+	if len(f.Days) == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+
+	start, err := f.Days[0].ParsedDate()
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	last, err := f.Days[len(f.Days)-1].ParsedDate()
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return start, last.AddDate(0, 0, 1), true
+}
+
+// PrecipitationByDay returns the total precipitation of each day keyed by ForecastDay.Date in its
+// string form (e.g., "2020-08-20Z"). Days without precipitation data are omitted.
+func (f MunicipalityHourlyForecast) PrecipitationByDay() map[string]float64 {
+	totals := make(map[string]float64, len(f.Days))
+	for _, day := range f.Days {
+		if total, _, ok := day.TotalPrecipitation(); ok {
+			totals[day.Date.String()] = total
+		}
+	}
+	return totals
+}
+
+// DailySkySummary returns the dominant sky state symbol code of each day (see ForecastDay.DominantSkyCode),
+// keyed by ForecastDay.Date in its string form (e.g., "2020-08-20Z"). Days without sky values are omitted.
+func (f MunicipalityHourlyForecast) DailySkySummary() map[string]string {
+	summary := make(map[string]string, len(f.Days))
+	for _, day := range f.Days {
+		if code, ok := day.DominantSkyCode(); ok {
+			summary[day.Date.String()] = code
+		}
+	}
+	return summary
+}
+
+// Completeness summarizes how complete the forecast is, to detect degraded API responses.
+// It reports the number of days returned, the number of hourly temperature values per day (keyed by
+// ForecastDay.Date as a string; a full forecast has about 24 per day, ~72 in total), and the API names of the
+// seven expected variables ("temp", "tempXafogor", "humitat", "precipitacio", "velVent", "dirVent",
+// "estatCel") that have no values on any day, in that order.
+func (f MunicipalityHourlyForecast) Completeness() (days int, hoursPerDay map[string]int, missingVariables []string) {
+	hoursPerDay = make(map[string]int, len(f.Days))
+	present := make(map[string]bool, 7)
+
+	for _, day := range f.Days {
+		hoursPerDay[day.Date.String()] = 0
+		v := day.Variables
+		if v == nil {
+			continue
+		}
+		if v.Temperature != nil {
+			hoursPerDay[day.Date.String()] = len(v.Temperature.Values)
+			present["temp"] = present["temp"] || len(v.Temperature.Values) > 0
+		}
+		if v.ApparentTemperature != nil {
+			present["tempXafogor"] = present["tempXafogor"] || len(v.ApparentTemperature.Values) > 0
+		}
+		if v.Humidity != nil {
+			present["humitat"] = present["humitat"] || len(v.Humidity.Values) > 0
+		}
+		if v.Precipitation != nil {
+			present["precipitacio"] = present["precipitacio"] || len(v.Precipitation.Values) > 0
+		}
+		if v.WindSpeed != nil {
+			present["velVent"] = present["velVent"] || len(v.WindSpeed.Values) > 0
+		}
+		if v.WindDirection != nil {
+			present["dirVent"] = present["dirVent"] || len(v.WindDirection.Values) > 0
+		}
+		if v.SkyConditions != nil {
+			present["estatCel"] = present["estatCel"] || len(v.SkyConditions.Values) > 0
+		}
+	}
+
+	for _, name := range []string{"temp", "tempXafogor", "humitat", "precipitacio", "velVent", "dirVent", "estatCel"} {
+		if !present[name] {
+			missingVariables = append(missingVariables, name)
+		}
+	}
+	return len(f.Days), hoursPerDay, missingVariables
+}
+
+// englishHourlyValue is the English-keyed shadow of HourlyValue used by MarshalEnglish.
+type englishHourlyValue struct {
+	Value StringOrFloat64 `json:"value"`
+	Time  MeteocatTime    `json:"time"`
+}
+
+// englishVariable is the English-keyed shadow shared by all hourly forecast variables.
+type englishVariable struct {
+	Unit   string               `json:"unit"`
+	Values []englishHourlyValue `json:"values"`
+}
+
+// englishForecastVariables is the English-keyed shadow of ForecastVariables.
+type englishForecastVariables struct {
+	Temperature         *englishVariable `json:"temperature,omitempty"`
+	ApparentTemperature *englishVariable `json:"apparentTemperature,omitempty"`
+	Humidity            *englishVariable `json:"humidity,omitempty"`
+	Precipitation       *englishVariable `json:"precipitation,omitempty"`
+	WindSpeed           *englishVariable `json:"windSpeed,omitempty"`
+	WindDirection       *englishVariable `json:"windDirection,omitempty"`
+	SkyConditions       *englishVariable `json:"skyConditions,omitempty"`
+}
+
+// englishForecastDay is the English-keyed shadow of ForecastDay.
+type englishForecastDay struct {
+	Date      MeteocatDate              `json:"date"`
+	Variables *englishForecastVariables `json:"variables"`
+}
+
+// englishMunicipalityHourlyForecast is the English-keyed shadow of MunicipalityHourlyForecast.
+type englishMunicipalityHourlyForecast struct {
+	MunicipalityCode string               `json:"municipalityCode"`
+	Days             []englishForecastDay `json:"days"`
+	IssuedAt         *MeteocatTime        `json:"issuedAt,omitempty"`
+}
+
+// newEnglishVariable converts a unit and its hourly values into the English-keyed shadow.
+func newEnglishVariable(unit string, values []HourlyValue) *englishVariable {
+	out := &englishVariable{Unit: unit, Values: make([]englishHourlyValue, 0, len(values))}
+	for _, v := range values {
+		out.Values = append(out.Values, englishHourlyValue{Value: v.Value, Time: v.Time})
+	}
+	return out
+}
+
+// MarshalEnglish encodes the forecast as JSON using English keys derived from the Go field names
+// (e.g., "municipalityCode", "days", "temperature") instead of the Catalan API keys.
+// The default encoding/json behavior is unchanged; this is a separate serialization view for
+// downstream systems that standardized on English.
+func (f MunicipalityHourlyForecast) MarshalEnglish() ([]byte, error) {
+	out := englishMunicipalityHourlyForecast{
+		MunicipalityCode: f.MunicipalityCode,
+		Days:             make([]englishForecastDay, 0, len(f.Days)),
+		IssuedAt:         f.IssuedAt,
+	}
+
+	for _, day := range f.Days {
+		shadow := englishForecastDay{Date: day.Date}
+		if v := day.Variables; v != nil {
+			shadow.Variables = &englishForecastVariables{}
+			if v.Temperature != nil {
+				shadow.Variables.Temperature = newEnglishVariable(v.Temperature.Unit, v.Temperature.Values)
+			}
+			if v.ApparentTemperature != nil {
+				shadow.Variables.ApparentTemperature = newEnglishVariable(v.ApparentTemperature.Unit, v.ApparentTemperature.Values)
+			}
+			if v.Humidity != nil {
+				shadow.Variables.Humidity = newEnglishVariable(v.Humidity.Unit, v.Humidity.Values)
+			}
+			if v.Precipitation != nil {
+				shadow.Variables.Precipitation = newEnglishVariable(v.Precipitation.Unit, v.Precipitation.Values)
+			}
+			if v.WindSpeed != nil {
+				shadow.Variables.WindSpeed = newEnglishVariable(v.WindSpeed.Unit, v.WindSpeed.Values)
+			}
+			if v.WindDirection != nil {
+				shadow.Variables.WindDirection = newEnglishVariable(v.WindDirection.Unit, v.WindDirection.Values)
+			}
+			if v.SkyConditions != nil {
+				shadow.Variables.SkyConditions = newEnglishVariable(v.SkyConditions.Unit, v.SkyConditions.Values)
+			}
+		}
+		out.Days = append(out.Days, shadow)
+	}
+
+	return json.Marshal(out)
+}
+
+// HourRecord groups all forecast variables available for a single hour.
+// Fields are nil when the corresponding variable has no value for that hour.
+type HourRecord struct {
+	// Time is the timestamp (in UTC) of the hour
+	Time time.Time
+
+	// Temperature is the temperature in degrees Celsius
+	Temperature *StringOrFloat64
+
+	// ApparentTemperature is the apparent temperature (feels like) in degrees Celsius
+	ApparentTemperature *StringOrFloat64
+
+	// Humidity is the relative humidity in percentage
+	Humidity *StringOrFloat64
+
+	// Precipitation is the precipitation in millimeters
+	Precipitation *StringOrFloat64
+
+	// WindSpeed is the wind speed in km/h
+	WindSpeed *StringOrFloat64
+
+	// WindDirection is the wind direction in degrees
+	WindDirection *StringOrFloat64
+
+	// SkyCode is the sky state symbol code
+	SkyCode *StringOrFloat64
+}
+
+// HourlyTimeline merges the day's per-variable series into one record per hour, sorted by time.
+// Values are matched by timestamp; an hour present in only some variables still produces a record
+// with the available fields set and the others left nil.
+func (d ForecastDay) HourlyTimeline() []HourRecord {
+	if d.Variables == nil {
+		return nil
+	}
+
+	var records []HourRecord
+	index := make(map[time.Time]int)
+
+	add := func(values []HourlyValue, field func(*HourRecord) **StringOrFloat64) {
+		for _, v := range values {
+			key := v.Time.UTC()
+			i, ok := index[key]
+			if !ok {
+				i = len(records)
+				index[key] = i
+				records = append(records, HourRecord{Time: key})
+			}
+			value := v.Value
+			*field(&records[i]) = &value
+		}
+	}
+
+	vars := d.Variables
+	if vars.Temperature != nil {
+		add(vars.Temperature.Values, func(r *HourRecord) **StringOrFloat64 { return &r.Temperature })
+	}
+	if vars.ApparentTemperature != nil {
+		add(vars.ApparentTemperature.Values, func(r *HourRecord) **StringOrFloat64 { return &r.ApparentTemperature })
+	}
+	if vars.Humidity != nil {
+		add(vars.Humidity.Values, func(r *HourRecord) **StringOrFloat64 { return &r.Humidity })
+	}
+	if vars.Precipitation != nil {
+		add(vars.Precipitation.Values, func(r *HourRecord) **StringOrFloat64 { return &r.Precipitation })
+	}
+	if vars.WindSpeed != nil {
+		add(vars.WindSpeed.Values, func(r *HourRecord) **StringOrFloat64 { return &r.WindSpeed })
+	}
+	if vars.WindDirection != nil {
+		add(vars.WindDirection.Values, func(r *HourRecord) **StringOrFloat64 { return &r.WindDirection })
+	}
+	if vars.SkyConditions != nil {
+		add(vars.SkyConditions.Values, func(r *HourRecord) **StringOrFloat64 { return &r.SkyCode })
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+
+	return records
+}
+
+// ForecastRecord is a single forecast value in long (tidy) format: one row per municipality, day,
+// hour and variable, suited for loading into columnar stores.
+type ForecastRecord struct {
+	// MunicipalityCode is the municipality the forecast belongs to
+	MunicipalityCode string
+
+	// Date is the forecast day as sent by the API (e.g., "2020-08-20Z")
+	Date string
+
+	// Time is the timestamp (in UTC) of the hour
+	Time time.Time
+
+	// Variable is the API name of the variable (e.g., "temp", "precipitacio")
+	Variable string
+
+	// Unit is the unit reported by the API for the variable
+	Unit string
+
+	// Value is the forecast value
+	Value StringOrFloat64
+}
+
+// LongRecords flattens the forecast into one ForecastRecord per day, hour and variable, complementing
+// the wide HourlyTimeline view. Records follow the order of the days, then the variables ("temp",
+// "tempXafogor", "humitat", "precipitacio", "velVent", "dirVent", "estatCel"), then the values.
+// Variables absent from a day and missing values (see HourlyValue.IsMissing) produce no records.
+func (f MunicipalityHourlyForecast) LongRecords() []ForecastRecord {
+	var records []ForecastRecord
+	for _, day := range f.Days {
+		vars := day.Variables
+		if vars == nil {
+			continue
+		}
+
+		add := func(variable, unit string, values []HourlyValue) {
+			for _, v := range values {
+				if v.IsMissing() {
+					continue
+				}
+				records = append(records, ForecastRecord{
+					MunicipalityCode: f.MunicipalityCode,
+					Date:             day.Date.String(),
+					Time:             v.Time.UTC(),
+					Variable:         variable,
+					Unit:             unit,
+					Value:            v.Value,
+				})
+			}
+		}
+
+		if vars.Temperature != nil {
+			add("temp", vars.Temperature.Unit, vars.Temperature.Values)
+		}
+		if vars.ApparentTemperature != nil {
+			add("tempXafogor", vars.ApparentTemperature.Unit, vars.ApparentTemperature.Values)
+		}
+		if vars.Humidity != nil {
+			add("humitat", vars.Humidity.Unit, vars.Humidity.Values)
+		}
+		if vars.Precipitation != nil {
+			add("precipitacio", vars.Precipitation.Unit, vars.Precipitation.Values)
+		}
+		if vars.WindSpeed != nil {
+			add("velVent", vars.WindSpeed.Unit, vars.WindSpeed.Values)
+		}
+		if vars.WindDirection != nil {
+			add("dirVent", vars.WindDirection.Unit, vars.WindDirection.Values)
+		}
+		if vars.SkyConditions != nil {
+			add("estatCel", vars.SkyConditions.Unit, vars.SkyConditions.Values)
+		}
+	}
+	return records
 }
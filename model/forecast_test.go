@@ -0,0 +1,546 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestForecast builds a two-day forecast fixture with temperature and precipitation.
+func newTestForecast() MunicipalityHourlyForecast {
+	return MunicipalityHourlyForecast{
+		MunicipalityCode: "250019",
+		Days: []ForecastDay{
+			{
+				Date: mustParseDate("2020-08-20Z"),
+				Variables: &ForecastVariables{
+					Temperature: &Temperature{
+						Unit: "°C",
+						Values: []HourlyValue{
+							{Value: "16.9", Time: MeteocatTime{Time: time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)}},
+							{Value: "17.6", Time: MeteocatTime{Time: time.Date(2020, 8, 20, 1, 0, 0, 0, time.UTC)}},
+						},
+					},
+					Precipitation: &Precipitation{
+						Unit: "mm",
+						Values: []HourlyValue{
+							{Value: "0.0", Time: MeteocatTime{Time: time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)}},
+							{Value: "0.4", Time: MeteocatTime{Time: time.Date(2020, 8, 20, 1, 0, 0, 0, time.UTC)}},
+						},
+					},
+				},
+			},
+			{
+				Date: mustParseDate("2020-08-21Z"),
+				Variables: &ForecastVariables{
+					Temperature: &Temperature{
+						Unit: "°C",
+						Values: []HourlyValue{
+							{Value: "18.2", Time: MeteocatTime{Time: time.Date(2020, 8, 21, 0, 0, 0, 0, time.UTC)}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestMunicipalityHourlyForecastMarshalEnglish verifies the English-keyed serialization view.
+func TestMunicipalityHourlyForecastMarshalEnglish(t *testing.T) {
+	forecast := newTestForecast()
+
+	data, err := forecast.MarshalEnglish()
+	if err != nil {
+		t.Fatalf("marshal english: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal english: %v", err)
+	}
+	if decoded["municipalityCode"] != "250019" {
+		t.Errorf("expected municipalityCode 250019, got %v", decoded["municipalityCode"])
+	}
+
+	days, ok := decoded["days"].([]any)
+	if !ok || len(days) != 2 {
+		t.Fatalf("expected 2 days, got %v", decoded["days"])
+	}
+	day := days[0].(map[string]any)
+	variables := day["variables"].(map[string]any)
+	temperature, ok := variables["temperature"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected temperature key, got %v", variables)
+	}
+	if temperature["unit"] != "°C" {
+		t.Errorf("expected unit °C, got %v", temperature["unit"])
+	}
+	if _, ok := variables["humidity"]; ok {
+		t.Error("expected absent humidity to be omitted")
+	}
+
+	for _, catalan := range []string{"codiMunicipi", "dies", "valors", "unitat"} {
+		if strings.Contains(string(data), `"`+catalan+`"`) {
+			t.Errorf("expected no Catalan key %q in %s", catalan, data)
+		}
+	}
+
+	// The default encoding keeps the Catalan API keys.
+	defaultData, err := json.Marshal(forecast)
+	if err != nil {
+		t.Fatalf("marshal default: %v", err)
+	}
+	if !strings.Contains(string(defaultData), `"codiMunicipi"`) {
+		t.Errorf("expected default marshaling to keep Catalan keys, got %s", defaultData)
+	}
+}
+
+// TestPrecipitationUnmarshalJSON verifies that precipitation values populate from both
+// the documented "valor" key and the "valors" key used by the other variables.
+func TestPrecipitationUnmarshalJSON(t *testing.T) {
+	payloads := map[string]string{
+		"valor":  `{"unitat":"mm","valor":[{"valor":"0.0","data":"2020-08-20T00:00Z"},{"valor":"1.2","data":"2020-08-20T01:00Z"}]}`,
+		"valors": `{"unitat":"mm","valors":[{"valor":"0.0","data":"2020-08-20T00:00Z"},{"valor":"1.2","data":"2020-08-20T01:00Z"}]}`,
+	}
+
+	for key, payload := range payloads {
+		var precipitation Precipitation
+		if err := json.Unmarshal([]byte(payload), &precipitation); err != nil {
+			t.Fatalf("%s: unmarshal: %v", key, err)
+		}
+		if precipitation.Unit != "mm" {
+			t.Errorf("%s: expected unit mm, got %s", key, precipitation.Unit)
+		}
+		if len(precipitation.Values) != 2 {
+			t.Fatalf("%s: expected 2 values, got %d", key, len(precipitation.Values))
+		}
+		if precipitation.Values[1].Value != "1.2" {
+			t.Errorf("%s: expected value 1.2, got %s", key, precipitation.Values[1].Value)
+		}
+		if !precipitation.Values[1].Time.Equal(NewMeteocatTime(time.Date(2020, 8, 20, 1, 0, 0, 0, time.UTC))) {
+			t.Errorf("%s: unexpected time %v", key, precipitation.Values[1].Time)
+		}
+	}
+}
+
+// TestForecastDayHourlyTimeline verifies that variables are merged by timestamp,
+// keeping hours present in only one variable.
+func TestForecastDayHourlyTimeline(t *testing.T) {
+	day := newTestForecast().Days[0]
+	day.Variables.Precipitation.Values = append(day.Variables.Precipitation.Values, HourlyValue{
+		Value: "2.1",
+		Time:  MeteocatTime{Time: time.Date(2020, 8, 20, 2, 0, 0, 0, time.UTC)},
+	})
+
+	timeline := day.HourlyTimeline()
+	if len(timeline) != 3 {
+		t.Fatalf("expected 3 hours, got %d", len(timeline))
+	}
+
+	for i, record := range timeline {
+		expected := time.Date(2020, 8, 20, i, 0, 0, 0, time.UTC)
+		if !record.Time.Equal(expected) {
+			t.Errorf("hour %d: expected time %v, got %v", i, expected, record.Time)
+		}
+		if record.Humidity != nil || record.SkyCode != nil {
+			t.Errorf("hour %d: expected absent variables to be nil", i)
+		}
+	}
+
+	if timeline[1].Temperature == nil || *timeline[1].Temperature != "17.6" {
+		t.Errorf("expected temperature 17.6 at 01:00, got %v", timeline[1].Temperature)
+	}
+	if timeline[1].Precipitation == nil || *timeline[1].Precipitation != "0.4" {
+		t.Errorf("expected precipitation 0.4 at 01:00, got %v", timeline[1].Precipitation)
+	}
+	if timeline[2].Temperature != nil {
+		t.Errorf("expected no temperature at 02:00, got %v", *timeline[2].Temperature)
+	}
+	if timeline[2].Precipitation == nil || *timeline[2].Precipitation != "2.1" {
+		t.Errorf("expected precipitation 2.1 at 02:00, got %v", timeline[2].Precipitation)
+	}
+
+	if (ForecastDay{}).HourlyTimeline() != nil {
+		t.Error("expected nil timeline for a day without variables")
+	}
+}
+
+// TestMunicipalityHourlyForecastReferencedSymbolCodes verifies normalized, deduplicated sky codes.
+func TestMunicipalityHourlyForecastReferencedSymbolCodes(t *testing.T) {
+	forecast := newTestForecast()
+	if codes := forecast.ReferencedSymbolCodes(); codes != nil {
+		t.Errorf("expected no codes without sky conditions, got %v", codes)
+	}
+
+	forecast.Days[0].Variables.SkyConditions = &SkyConditions{Values: []HourlyValue{{Value: "3"}, {Value: "1.0"}, {Value: ""}}}
+	forecast.Days[1].Variables.SkyConditions = &SkyConditions{Values: []HourlyValue{{Value: "3.0"}, {Value: "21"}}}
+	if got := strings.Join(forecast.ReferencedSymbolCodes(), ","); got != "3,1,21" {
+		t.Errorf("expected 3,1,21, got %s", got)
+	}
+}
+
+// TestForecastDayDominantSkyCode verifies frequency counting and the daytime tie-break.
+func TestForecastDayDominantSkyCode(t *testing.T) {
+	sky := func(hour int, code StringOrFloat64) HourlyValue {
+		return HourlyValue{Value: code, Time: MeteocatTime{Time: time.Date(2020, 8, 20, hour, 0, 0, 0, time.UTC)}}
+	}
+	forecast := newTestForecast()
+
+	// Clear night and overcast afternoon, three hours each: the afternoon wins the tie.
+	forecast.Days[0].Variables.SkyConditions = &SkyConditions{Values: []HourlyValue{
+		sky(0, "1"), sky(1, "1.0"), sky(2, "1"), sky(14, "21"), sky(15, "21"), sky(16, "21"), sky(17, ""),
+	}}
+	if code, ok := forecast.Days[0].DominantSkyCode(); !ok || code != "21" {
+		t.Errorf("expected overcast afternoon 21, got %q (ok=%v)", code, ok)
+	}
+
+	// A strict majority wins regardless of the time of day.
+	forecast.Days[1].Variables.SkyConditions = &SkyConditions{Values: []HourlyValue{
+		sky(0, "1"), sky(1, "1"), sky(12, "3"),
+	}}
+	if code, ok := forecast.Days[1].DominantSkyCode(); !ok || code != "1" {
+		t.Errorf("expected majority code 1, got %q (ok=%v)", code, ok)
+	}
+
+	// Summer tie between 09:00-10:00 and 19:00-20:00 local time (07:00 and 17:00 UTC): the morning
+	// hours fall in the daytime window, the evening ones only would in UTC.
+	summer := ForecastDay{Variables: &ForecastVariables{SkyConditions: &SkyConditions{Values: []HourlyValue{
+		sky(17, "21"), sky(18, "21"), sky(7, "3"), sky(8, "3"),
+	}}}}
+	if code, ok := summer.DominantSkyCode(); !ok || code != "3" {
+		t.Errorf("expected the local daytime code 3, got %q (ok=%v)", code, ok)
+	}
+
+	summary := forecast.DailySkySummary()
+	if len(summary) != 2 || summary["2020-08-20Z"] != "21" || summary["2020-08-21Z"] != "1" {
+		t.Errorf("unexpected summary %v", summary)
+	}
+
+	if _, ok := (ForecastDay{}).DominantSkyCode(); ok {
+		t.Error("expected no dominant code for a day without sky values")
+	}
+}
+
+// TestMunicipalityHourlyForecastLongRecords verifies one record per day, hour and present variable.
+func TestMunicipalityHourlyForecastLongRecords(t *testing.T) {
+	forecast := newTestForecast()
+
+	records := forecast.LongRecords()
+	if len(records) != 5 {
+		t.Fatalf("expected 5 records, got %d", len(records))
+	}
+
+	expected := ForecastRecord{
+		MunicipalityCode: "250019",
+		Date:             "2020-08-20Z",
+		Time:             time.Date(2020, 8, 20, 1, 0, 0, 0, time.UTC),
+		Variable:         "precipitacio",
+		Unit:             "mm",
+		Value:            "0.4",
+	}
+	if records[3] != expected {
+		t.Errorf("expected %+v, got %+v", expected, records[3])
+	}
+	if last := records[4]; last.Date != "2020-08-21Z" || last.Variable != "temp" || last.Value != "18.2" {
+		t.Errorf("unexpected last record %+v", last)
+	}
+
+	forecast.Days[1].Variables.Temperature.Values[0].Value = ""
+	forecast.Days[0].Variables = nil
+	if got := forecast.LongRecords(); len(got) != 0 {
+		t.Errorf("expected no records without present values, got %+v", got)
+	}
+}
+
+// TestForecastDayDateJSON verifies that the day date round-trips in the API form and still reads as text.
+func TestForecastDayDateJSON(t *testing.T) {
+	var day ForecastDay
+	if err := json.Unmarshal([]byte(`{"data":"2020-08-20Z","variables":null}`), &day); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !day.Date.Equal(time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2020-08-20 UTC, got %v", day.Date.Time)
+	}
+	if day.Date.String() != "2020-08-20Z" || fmt.Sprint(day.Date) != "2020-08-20Z" {
+		t.Errorf("expected the text form 2020-08-20Z, got %q", day.Date.String())
+	}
+
+	data, err := json.Marshal(day)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(data) != `{"data":"2020-08-20Z","variables":null}` {
+		t.Errorf("unexpected encoding %s", data)
+	}
+
+	if _, ok := newTestForecast().PrecipitationByDay()["2020-08-20Z"]; !ok {
+		t.Error("expected per-day results keyed by the date text")
+	}
+}
+
+// TestMunicipalityHourlyForecast_EmptyDayDate verifies that a day without a date is decoded and
+// reported per day instead of failing the whole forecast.
+func TestMunicipalityHourlyForecast_EmptyDayDate(t *testing.T) {
+	body := `{"codiMunicipi":"250019","dies":[` +
+		`{"data":"2020-08-20Z","variables":{"temp":{"unitat":"°C","valors":[{"valor":"16.9","data":"2020-08-20T00:00Z"}]}}},` +
+		`{"data":"","variables":{"temp":{"unitat":"°C","valors":[{"valor":"18.2","data":"2020-08-21T00:00Z"}]}}}]}`
+
+	var forecast MunicipalityHourlyForecast
+	if err := json.Unmarshal([]byte(body), &forecast); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(forecast.Days) != 2 || forecast.Days[1].Variables == nil || forecast.Days[1].Variables.Temperature == nil {
+		t.Fatalf("expected both days to be decoded, got %+v", forecast.Days)
+	}
+	if !forecast.Days[1].Date.IsZero() {
+		t.Errorf("expected a zero date, got %v", forecast.Days[1].Date)
+	}
+	if _, err := forecast.Days[1].ParsedDate(); err == nil {
+		t.Error("expected ParsedDate to report the missing date")
+	}
+	if _, _, ok := forecast.Window(); ok {
+		t.Error("expected no window with a missing last day date")
+	}
+	if solar := forecast.WithSolar(Coordinates{Latitude: 41.39, Longitude: 2.17}); !solar.Days[0].HasSolarEvents || solar.Days[1].HasSolarEvents {
+		t.Error("expected solar events only for the dated day")
+	}
+}
+
+// TestForecastDayParsedDate verifies the date conversion and the missing date case.
+func TestForecastDayParsedDate(t *testing.T) {
+	date, err := ForecastDay{Date: mustParseDate("2020-08-20Z")}.ParsedDate()
+	if err != nil {
+		t.Fatalf("parse date: %v", err)
+	}
+	if !date.Equal(time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2020-08-20 UTC, got %v", date)
+	}
+
+	if _, err := (ForecastDay{}).ParsedDate(); err == nil {
+		t.Error("expected error for a missing date, got nil")
+	}
+}
+
+// TestMunicipalityHourlyForecastIsStale_Timestamped verifies staleness measured from IssuedAt.
+func TestMunicipalityHourlyForecastIsStale_Timestamped(t *testing.T) {
+	forecast := newTestForecast()
+	issued := NewMeteocatTime(time.Date(2020, 8, 20, 5, 0, 0, 0, time.UTC))
+	forecast.IssuedAt = &issued
+
+	if forecast.IsStale(time.Date(2020, 8, 20, 16, 0, 0, 0, time.UTC), 12*time.Hour) {
+		t.Error("expected forecast issued 11h ago to be fresh")
+	}
+	if !forecast.IsStale(time.Date(2020, 8, 20, 18, 0, 0, 0, time.UTC), 12*time.Hour) {
+		t.Error("expected forecast issued 13h ago to be stale")
+	}
+}
+
+// TestMunicipalityHourlyForecastIsStale_DateDerived verifies staleness derived from the earliest day.
+func TestMunicipalityHourlyForecastIsStale_DateDerived(t *testing.T) {
+	forecast := newTestForecast()
+
+	if forecast.IsStale(time.Date(2020, 8, 20, 10, 0, 0, 0, time.UTC), 12*time.Hour) {
+		t.Error("expected forecast to be fresh 10h after its first day starts")
+	}
+	if !forecast.IsStale(time.Date(2020, 8, 21, 10, 0, 0, 0, time.UTC), 12*time.Hour) {
+		t.Error("expected forecast to be stale 34h after its first day starts")
+	}
+	if !(MunicipalityHourlyForecast{}).IsStale(time.Now(), time.Hour) {
+		t.Error("expected forecast without days to be stale")
+	}
+}
+
+// TestStringOrFloat64Float64 verifies numeric parsing of forecast values.
+func TestStringOrFloat64Float64(t *testing.T) {
+	if v, err := StringOrFloat64("16.9").Float64(); err != nil || v != 16.9 {
+		t.Errorf("expected 16.9, got %v (%v)", v, err)
+	}
+	if _, err := StringOrFloat64("n/a").Float64(); err == nil {
+		t.Error("expected error for non-numeric value, got nil")
+	}
+}
+
+// TestStringOrFloat64SymbolCode verifies normalization of numeric symbol codes.
+func TestStringOrFloat64SymbolCode(t *testing.T) {
+	testCases := map[StringOrFloat64]string{
+		"3":   "3",
+		"3.0": "3",
+		"3a":  "3a",
+		"2.5": "2.5",
+	}
+	for value, expected := range testCases {
+		if got := value.SymbolCode(); got != expected {
+			t.Errorf("SymbolCode(%q): expected %q, got %q", value, expected, got)
+		}
+	}
+}
+
+// TestForecastDayTotalPrecipitation verifies daily precipitation totals and invalid value handling.
+func TestForecastDayTotalPrecipitation(t *testing.T) {
+	forecast := newTestForecast()
+
+	total, unit, ok := forecast.Days[0].TotalPrecipitation()
+	if !ok {
+		t.Fatal("expected precipitation data for the first day")
+	}
+	if unit != "mm" {
+		t.Errorf("expected unit mm, got %s", unit)
+	}
+	if total != 0.4 {
+		t.Errorf("expected total 0.4, got %v", total)
+	}
+
+	if _, _, ok := forecast.Days[1].TotalPrecipitation(); ok {
+		t.Error("expected ok=false for a day without precipitation")
+	}
+
+	var invalid []StringOrFloat64
+	onInvalid := func(variable string, value HourlyValue) {
+		if variable != "precipitacio" {
+			t.Errorf("expected variable precipitacio, got %s", variable)
+		}
+		invalid = append(invalid, value.Value)
+	}
+
+	day := forecast.Days[0]
+	day.Variables.Precipitation.Values = append(day.Variables.Precipitation.Values,
+		HourlyValue{Value: "-1.0"},
+		HourlyValue{Value: "n/a"},
+		HourlyValue{Value: "1.1"},
+		HourlyValue{Value: ""},
+	)
+	if total, _, _ = day.TotalPrecipitation(); total != 1.5 {
+		t.Errorf("expected total 1.5 without a hook, got %v", total)
+	}
+	total, _, _ = day.TotalPrecipitationWithHook(onInvalid)
+	if total != 1.5 {
+		t.Errorf("expected total 1.5, got %v", total)
+	}
+	if len(invalid) != 2 {
+		t.Errorf("expected 2 invalid values reported (missing values skipped silently), got %v", invalid)
+	}
+}
+
+// TestHourlyValueIsMissing verifies that empty and null values are missing while zero readings are not.
+func TestHourlyValueIsMissing(t *testing.T) {
+	testCases := []struct {
+		json    string
+		missing bool
+	}{
+		{`{"valor":""}`, true},
+		{`{"valor":null}`, true},
+		{`{}`, true},
+		{`{"valor":"0.0"}`, false},
+		{`{"valor":0}`, false},
+		{`{"valor":"12.5"}`, false},
+	}
+
+	for _, tc := range testCases {
+		var v HourlyValue
+		if err := json.Unmarshal([]byte(tc.json), &v); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.json, err)
+		}
+		if got := v.IsMissing(); got != tc.missing {
+			t.Errorf("%s: expected IsMissing %t, got %t", tc.json, tc.missing, got)
+		}
+	}
+
+	var s StringOrFloat64
+	if err := s.UnmarshalJSON(nil); err != nil || (HourlyValue{Value: s}).IsMissing() != true {
+		t.Errorf("expected empty input to decode as missing, got %q (%v)", s, err)
+	}
+}
+
+// TestMunicipalityHourlyForecastPrecipitationByDay verifies per-day precipitation totals.
+func TestMunicipalityHourlyForecastPrecipitationByDay(t *testing.T) {
+	totals := newTestForecast().PrecipitationByDay()
+
+	if len(totals) != 1 {
+		t.Fatalf("expected 1 day with precipitation, got %d", len(totals))
+	}
+	if totals["2020-08-20Z"] != 0.4 {
+		t.Errorf("expected 0.4 for 2020-08-20Z, got %v", totals["2020-08-20Z"])
+	}
+}
+
+// TestMunicipalityHourlyForecastCompleteness verifies day counts, hourly points and missing variables.
+func TestMunicipalityHourlyForecastCompleteness(t *testing.T) {
+	full := make([]HourlyValue, 24)
+	for i := range full {
+		full[i] = HourlyValue{Value: "20.0", Time: MeteocatTime{Time: time.Date(2020, 8, 20, i, 0, 0, 0, time.UTC)}}
+	}
+	forecast := MunicipalityHourlyForecast{
+		Days: []ForecastDay{
+			{
+				Date: mustParseDate("2020-08-20Z"),
+				Variables: &ForecastVariables{
+					Temperature:   &Temperature{Values: full},
+					Humidity:      &Humidity{Values: full},
+					SkyConditions: &SkyConditions{Values: full},
+				},
+			},
+			{
+				Date: mustParseDate("2020-08-21Z"),
+				Variables: &ForecastVariables{
+					Temperature: &Temperature{Values: full[:3]},
+					WindSpeed:   &WindSpeed{Values: []HourlyValue{}},
+				},
+			},
+		},
+	}
+
+	days, hours, missing := forecast.Completeness()
+	if days != 2 {
+		t.Errorf("expected 2 days, got %d", days)
+	}
+	if hours["2020-08-20Z"] != 24 || hours["2020-08-21Z"] != 3 {
+		t.Errorf("unexpected hours per day %v", hours)
+	}
+	if got := strings.Join(missing, ","); got != "tempXafogor,precipitacio,velVent,dirVent" {
+		t.Errorf("unexpected missing variables %q", got)
+	}
+}
+
+// TestMunicipalityHourlyForecastWindow verifies the covered period of the two-day fixture and
+// the empty and unparseable cases.
+func TestMunicipalityHourlyForecastWindow(t *testing.T) {
+	start, end, ok := newTestForecast().Window()
+	if !ok {
+		t.Fatal("expected a window for the two-day fixture")
+	}
+	if !start.Equal(time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected window [%v, %v)", start, end)
+	}
+
+	if _, _, ok := (MunicipalityHourlyForecast{}).Window(); ok {
+		t.Error("expected no window without days")
+	}
+
+	forecast := newTestForecast()
+	forecast.Days[1].Date = MeteocatDate{}
+	if _, _, ok := forecast.Window(); ok {
+		t.Error("expected no window with a missing last day date")
+	}
+}
+
+// TestCatalanTime verifies the CET/CEST switch on the last Sundays of March and October.
+func TestCatalanTime(t *testing.T) {
+	testCases := []struct {
+		utc      time.Time
+		expected string
+	}{
+		{time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC), "13:00 CET"},
+		{time.Date(2024, 3, 31, 0, 59, 0, 0, time.UTC), "01:59 CET"},
+		{time.Date(2024, 3, 31, 1, 0, 0, 0, time.UTC), "03:00 CEST"},
+		{time.Date(2024, 8, 20, 17, 0, 0, 0, time.UTC), "19:00 CEST"},
+		{time.Date(2024, 10, 27, 0, 59, 0, 0, time.UTC), "02:59 CEST"},
+		{time.Date(2024, 10, 27, 1, 0, 0, 0, time.UTC), "02:00 CET"},
+	}
+	for _, tc := range testCases {
+		if got := catalanTime(tc.utc).Format("15:04 MST"); got != tc.expected {
+			t.Errorf("%v: expected %s, got %s", tc.utc, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package model
+
+import "encoding/json"
+
+// geoJSONFeatureCollection is a GeoJSON (RFC 7946) FeatureCollection.
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+// geoJSONFeature is a GeoJSON Feature with a Point geometry.
+type geoJSONFeature struct {
+	Type       string         `json:"type"`
+	Geometry   geoJSONPoint   `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+// geoJSONPoint is a GeoJSON Point; coordinates are ordered [longitude, latitude].
+type geoJSONPoint struct {
+	Type        string     `json:"type"`
+	Coordinates [2]float64 `json:"coordinates"`
+}
+
+// newGeoJSONFeature builds a Point feature at c with the given properties.
+func newGeoJSONFeature(c Coordinates, properties map[string]any) geoJSONFeature {
+	return geoJSONFeature{
+		Type:       "Feature",
+		Geometry:   geoJSONPoint{Type: "Point", Coordinates: [2]float64{c.Longitude, c.Latitude}},
+		Properties: properties,
+	}
+}
+
+// marshalGeoJSON encodes features as a FeatureCollection. An empty list yields an empty features array.
+func marshalGeoJSON(features []geoJSONFeature) ([]byte, error) {
+	if features == nil {
+		features = []geoJSONFeature{}
+	}
+	return json.Marshal(geoJSONFeatureCollection{Type: "FeatureCollection", Features: features})
+}
+
+// GeoJSON encodes the stations as a GeoJSON FeatureCollection of Points, ready for mapping tools
+// such as Leaflet or QGIS. Each feature carries the station code, name, network and province as
+// properties. Stations with zero or invalid coordinates are skipped.
+func (l StationList) GeoJSON() ([]byte, error) {
+	features := make([]geoJSONFeature, 0, len(l))
+	for _, s := range l {
+		if s.Coordinates.IsZero() || !s.Coordinates.Valid() {
+			continue
+		}
+		features = append(features, newGeoJSONFeature(s.Coordinates, map[string]any{
+			"code":     s.Code,
+			"name":     s.Name,
+			"network":  s.Network.Name,
+			"province": s.Province.Name,
+		}))
+	}
+	return marshalGeoJSON(features)
+}
+
+// GeoJSON encodes the municipalities as a GeoJSON FeatureCollection of Points located at each
+// municipality center, with the municipality code and name as properties.
+// Municipalities without coordinates, or with zero or invalid coordinates, are skipped.
+func (l MunicipalityList) GeoJSON() ([]byte, error) {
+	features := make([]geoJSONFeature, 0, len(l))
+	for _, m := range l {
+		if m.Coordinates == nil || m.Coordinates.IsZero() || !m.Coordinates.Valid() {
+			continue
+		}
+		features = append(features, newGeoJSONFeature(*m.Coordinates, map[string]any{
+			"code": m.Code,
+			"name": m.Name,
+		}))
+	}
+	return marshalGeoJSON(features)
+}
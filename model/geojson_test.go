@@ -0,0 +1,106 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// decodedFeatureCollection mirrors the GeoJSON output for assertions.
+type decodedFeatureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Type     string `json:"type"`
+		Geometry struct {
+			Type        string    `json:"type"`
+			Coordinates []float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]any `json:"properties"`
+	} `json:"features"`
+}
+
+func decodeFeatureCollection(t *testing.T, data []byte) decodedFeatureCollection {
+	t.Helper()
+
+	var fc decodedFeatureCollection
+	if err := json.Unmarshal(data, &fc); err != nil {
+		t.Fatalf("unmarshal geojson: %v", err)
+	}
+	if fc.Type != "FeatureCollection" {
+		t.Fatalf("expected FeatureCollection, got %q", fc.Type)
+	}
+	return fc
+}
+
+// TestStationListGeoJSON verifies feature structure, [lon, lat] order and skipping of missing coordinates.
+func TestStationListGeoJSON(t *testing.T) {
+	stations := StationList{
+		{
+			Code:        "CC",
+			Name:        "Orís",
+			Coordinates: Coordinates{Latitude: 42.07398, Longitude: 2.20862},
+			Province:    StationProvince{Code: 17, Name: "Girona"},
+			Network:     StationNetwork{Code: 1, Name: "XEMA"},
+		},
+		{Code: "ZZ", Name: "Sense coordenades"},
+		{Code: "XX", Name: "Invàlida", Coordinates: Coordinates{Latitude: 120, Longitude: 2}},
+	}
+
+	data, err := stations.GeoJSON()
+	if err != nil {
+		t.Fatalf("geojson: %v", err)
+	}
+	fc := decodeFeatureCollection(t, data)
+	if len(fc.Features) != 1 {
+		t.Fatalf("expected 1 feature, got %d", len(fc.Features))
+	}
+
+	feature := fc.Features[0]
+	if feature.Type != "Feature" || feature.Geometry.Type != "Point" {
+		t.Errorf("expected Point feature, got %q/%q", feature.Type, feature.Geometry.Type)
+	}
+	if len(feature.Geometry.Coordinates) != 2 || feature.Geometry.Coordinates[0] != 2.20862 || feature.Geometry.Coordinates[1] != 42.07398 {
+		t.Errorf("expected [lon, lat] = [2.20862, 42.07398], got %v", feature.Geometry.Coordinates)
+	}
+	expected := map[string]any{"code": "CC", "name": "Orís", "network": "XEMA", "province": "Girona"}
+	for key, want := range expected {
+		if feature.Properties[key] != want {
+			t.Errorf("property %s: expected %v, got %v", key, want, feature.Properties[key])
+		}
+	}
+}
+
+// TestMunicipalityListGeoJSON verifies municipality features and skipping of nil coordinates.
+func TestMunicipalityListGeoJSON(t *testing.T) {
+	municipalities := MunicipalityList{
+		{Code: "080193", Name: "Barcelona", Coordinates: &Coordinates{Latitude: 41.3874, Longitude: 2.1686}},
+		{Code: "250019", Name: "Abella de la Conca"},
+		{Code: "000000", Name: "Zero", Coordinates: &Coordinates{}},
+	}
+
+	data, err := municipalities.GeoJSON()
+	if err != nil {
+		t.Fatalf("geojson: %v", err)
+	}
+	fc := decodeFeatureCollection(t, data)
+	if len(fc.Features) != 1 {
+		t.Fatalf("expected 1 feature, got %d", len(fc.Features))
+	}
+	feature := fc.Features[0]
+	if feature.Geometry.Coordinates[0] != 2.1686 || feature.Geometry.Coordinates[1] != 41.3874 {
+		t.Errorf("expected [lon, lat] = [2.1686, 41.3874], got %v", feature.Geometry.Coordinates)
+	}
+	if feature.Properties["code"] != "080193" || feature.Properties["name"] != "Barcelona" {
+		t.Errorf("unexpected properties %v", feature.Properties)
+	}
+}
+
+// TestGeoJSON_Empty verifies that empty lists produce an empty features array rather than null.
+func TestGeoJSON_Empty(t *testing.T) {
+	data, err := StationList(nil).GeoJSON()
+	if err != nil {
+		t.Fatalf("geojson: %v", err)
+	}
+	if string(data) != `{"type":"FeatureCollection","features":[]}` {
+		t.Errorf("unexpected output %s", data)
+	}
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// Interpolated returns the temperature series with short gaps filled by linear interpolation between
+// the neighboring values, for smooth charts. A gap is the run of missing hours between two numeric
+// values: hours with no entry at all, or with a missing or non-numeric value. Gaps spanning at most
+// maxGap (e.g., time.Hour fills single missing hours) get one value per hour, rounded to one decimal
+// and flagged with Interpolated; longer gaps and leading or trailing ones are left untouched.
+// Values are expected in chronological order; t is not modified.
+func (t Temperature) Interpolated(maxGap time.Duration) []HourlyValue {
+	return interpolateHourly(t.Values, maxGap)
+}
+
+// interpolateHourly implements Temperature.Interpolated for any hourly series.
+func interpolateHourly(values []HourlyValue, maxGap time.Duration) []HourlyValue {
+	out := make([]HourlyValue, 0, len(values))
+
+	var prevTime time.Time
+	var prevValue float64
+	hasPrev := false
+	var pending []HourlyValue // non-numeric values since the previous numeric one
+
+	for _, v := range values {
+		value, err := v.Value.Float64()
+		if v.IsMissing() || err != nil {
+			pending = append(pending, v)
+			continue
+		}
+
+		current := v.Time.UTC()
+		if hasPrev {
+			if missing := current.Sub(prevTime) - time.Hour; missing > 0 && missing <= maxGap {
+				span := current.Sub(prevTime).Hours()
+				for at := prevTime.Add(time.Hour); at.Before(current); at = at.Add(time.Hour) {
+					interpolated := prevValue + (value-prevValue)*at.Sub(prevTime).Hours()/span
+					out = append(out, HourlyValue{
+						Value:        StringOrFloat64(strconv.FormatFloat(interpolated, 'f', 1, 64)),
+						Time:         NewMeteocatTime(at),
+						Interpolated: true,
+					})
+				}
+				pending = nil
+			}
+		}
+
+		out = append(out, pending...)
+		pending = nil
+		out = append(out, v)
+		prevTime, prevValue, hasPrev = current, value, true
+	}
+	return append(out, pending...)
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTemperatureInterpolated verifies that a one-hour gap is filled and a longer one is left untouched.
+func TestTemperatureInterpolated(t *testing.T) {
+	at := func(hour int) MeteocatTime {
+		return MeteocatTime{Time: time.Date(2020, 8, 20, hour, 0, 0, 0, time.UTC)}
+	}
+	temperature := Temperature{Unit: "°C", Values: []HourlyValue{
+		{Value: "16.0", Time: at(0)},
+		{Value: "17.0", Time: at(2)}, // 01:00 absent
+		{Value: "20.0", Time: at(3)},
+		{Value: "", Time: at(4)}, // 04:00 missing
+		{Value: "18.0", Time: at(5)},
+		{Value: "12.0", Time: at(9)}, // 06:00-08:00 absent
+	}}
+
+	got := temperature.Interpolated(time.Hour)
+
+	expected := []struct {
+		hour         int
+		value        StringOrFloat64
+		interpolated bool
+	}{
+		{0, "16.0", false},
+		{1, "16.5", true},
+		{2, "17.0", false},
+		{3, "20.0", false},
+		{4, "19.0", true},
+		{5, "18.0", false},
+		{9, "12.0", false},
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %d values, got %d: %+v", len(expected), len(got), got)
+	}
+	for i, want := range expected {
+		if !got[i].Time.Equal(at(want.hour)) || got[i].Value != want.value || got[i].Interpolated != want.interpolated {
+			t.Errorf("value %d: expected %02d:00 %s (interpolated=%v), got %+v", i, want.hour, want.value, want.interpolated, got[i])
+		}
+	}
+	if len(temperature.Values) != 6 || temperature.Values[3].Value != "" {
+		t.Error("expected the original series to be unchanged")
+	}
+
+	if filled := temperature.Interpolated(3 * time.Hour); len(filled) != 10 || filled[8].Value != "13.5" || !filled[8].Interpolated {
+		t.Errorf("expected the 3-hour gap to be filled with a wider maxGap, got %+v", filled)
+	}
+}
@@ -0,0 +1,19 @@
+package model
+
+import "encoding/json"
+
+// Meta describes the HTTP response that produced a decoded result.
+// It is returned by the ...WithMeta client methods so callers can tell an empty result
+// apart from a response without content.
+type Meta struct {
+	// StatusCode is the HTTP status code of the response, or zero if no response was received
+	StatusCode int
+
+	// NoContent is true when the API answered 204 No Content with an empty body,
+	// in which case the decoded result is left at its zero value
+	NoContent bool
+
+	// Raw is the body of a successful response after charset normalization (UTF-8), as it was
+	// unmarshaled. It is only set for the ...Raw client methods (see WithRawResponseAccess).
+	Raw json.RawMessage
+}
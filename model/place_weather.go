@@ -0,0 +1,23 @@
+package model
+
+// PlaceWeather combines the hourly forecast of a municipality with the observations of a station,
+// e.g. to show current conditions next to the upcoming forecast. Each part is fetched independently,
+// so one may be present while the other failed.
+type PlaceWeather struct {
+	// Forecast is the hourly forecast of the municipality; zero when ForecastErr is set.
+	Forecast MunicipalityHourlyForecast
+
+	// ForecastErr is the error of the forecast request, or nil if it succeeded.
+	ForecastErr *APIError
+
+	// Observations are the station observations for the requested day; nil when ObservationsErr is set.
+	Observations StationObservationList
+
+	// ObservationsErr is the error of the observations request, or nil if it succeeded.
+	ObservationsErr *APIError
+}
+
+// Complete reports whether both the forecast and the observations were fetched successfully.
+func (p PlaceWeather) Complete() bool {
+	return p.ForecastErr == nil && p.ObservationsErr == nil
+}
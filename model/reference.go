@@ -1,5 +1,12 @@
 package model
 
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"time"
+)
+
 // Region represents a regional administrative division with its unique identifier and name.
 // This data structure is used by the METEOCAT API to provide regional reference information.
 // Regions are used as geographic divisions and are referenced by other endpoints
@@ -12,9 +19,35 @@ type Region struct {
 	Name string `json:"nom"`
 }
 
+// UnmarshalJSON decodes a region, accepting the code as a JSON number or a numeric string.
+func (r *Region) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Code IntOrString `json:"codi"`
+		Name string      `json:"nom"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.Code = int(raw.Code)
+	r.Name = raw.Name
+	return nil
+}
+
 // RegionList represents a collection of regions returned by the METEOCAT API
 type RegionList []Region
 
+// ByCode returns the region with the given code, reporting false when none matches.
+// The returned region is a copy; modifying it does not affect the list.
+func (l RegionList) ByCode(code int) (*Region, bool) {
+	for _, r := range l {
+		if r.Code == code {
+			region := r
+			return &region, true
+		}
+	}
+	return nil, false
+}
+
 // Municipality represents a municipality with its geographic and administrative information.
 // This data structure is used by the METEOCAT API to provide specific municipality information
 // including coordinates and the region to which it belongs.
@@ -36,6 +69,50 @@ type Municipality struct {
 // MunicipalityList represents a collection of municipalities returned by the METEOCAT API
 type MunicipalityList []Municipality
 
+// municipalityCodeLength is the number of digits of a municipality code.
+const municipalityCodeLength = 6
+
+// NormalizeMunicipalityCode returns code with surrounding whitespace removed and, when it is made only
+// of digits and shorter than 6, left-padded with zeros (e.g., " 250019 " becomes "250019" and "25019"
+// becomes "025019"), recovering codes whose leading zero was lost in a numeric column.
+// Other codes are returned trimmed but otherwise unchanged.
+func NormalizeMunicipalityCode(code string) string {
+	code = strings.TrimSpace(code)
+	if code == "" || len(code) >= municipalityCodeLength {
+		return code
+	}
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return code
+		}
+	}
+	return strings.Repeat("0", municipalityCodeLength-len(code)) + code
+}
+
+// Nearest returns the municipality whose center is closest to coord (great-circle distance).
+// Municipalities without coordinates, or with zero or invalid ones, are skipped. It reports false
+// when no municipality has usable coordinates. The returned municipality is a copy.
+func (l MunicipalityList) Nearest(coord Coordinates) (*Municipality, bool) {
+	var nearest *Municipality
+	best := math.Inf(1)
+	for i := range l {
+		c := l[i].Coordinates
+		if c == nil || c.IsZero() || !c.Valid() {
+			continue
+		}
+		if d := coord.DistanceKm(*c); d < best {
+			best = d
+			nearest = &l[i]
+		}
+	}
+	if nearest == nil {
+		return nil, false
+	}
+
+	municipality := *nearest
+	return &municipality, true
+}
+
 // SymbolValue represents an individual meteorological symbol value within a symbol category.
 // Each value corresponds to a specific meteorological condition or state within its category,
 // with associated icons for day and night representation.
@@ -60,6 +137,18 @@ type SymbolValue struct {
 	IconURLNight string `json:"icona_nit"`
 }
 
+// IconFor returns the icon URL appropriate for the instant t given the day's sunrise and sunset.
+// The night icon is returned when t is before sunrise or after sunset, falling back to the day
+// icon when no night icon is available; otherwise the day icon is returned.
+//
+// Sunrise and sunset for a location can be computed with SunriseSunset.
+func (v SymbolValue) IconFor(t time.Time, sunrise, sunset time.Time) string {
+	if (t.Before(sunrise) || t.After(sunset)) && v.IconURLNight != "" {
+		return v.IconURLNight
+	}
+	return v.IconURL
+}
+
 // Symbol represents a meteorological symbol category with its possible values and descriptions.
 // This data structure groups related weather condition symbols together, such as sky state,
 // precipitation types, or snow accumulation, each with their specific codes and representations.
@@ -79,3 +168,53 @@ type Symbol struct {
 
 // SymbolList represents a collection of meteorological symbol categories returned by the METEOCAT API
 type SymbolList []Symbol
+
+// Resolve looks up the symbol value with the given code in the named category (e.g., "cel" for sky state).
+// Codes are compared in normalized form (see StringOrFloat64.SymbolCode), so a forecast value of "3.0"
+// resolves to the symbol with code "3". Each call scans the list; build an Index once to resolve many codes.
+func (l SymbolList) Resolve(category string, code StringOrFloat64) (SymbolValue, bool) {
+	want := code.SymbolCode()
+	for _, symbol := range l {
+		if symbol.Name != category {
+			continue
+		}
+		for _, v := range symbol.Values {
+			if StringOrFloat64(v.Code).SymbolCode() == want {
+				return v, true
+			}
+		}
+	}
+	return SymbolValue{}, false
+}
+
+// SymbolIndex maps each symbol category name to its values keyed by normalized code
+// (see StringOrFloat64.SymbolCode). It is built with SymbolList.Index.
+type SymbolIndex map[string]map[string]SymbolValue
+
+// Index builds a category -> code -> value index of the list for constant-time lookups, which pays
+// off when resolving many forecast or observation symbol references against the same catalog.
+// Codes are keyed in normalized form, and when a category repeats a code the first value is kept,
+// so lookups match Resolve.
+func (l SymbolList) Index() SymbolIndex {
+	index := make(SymbolIndex, len(l))
+	for _, symbol := range l {
+		values, ok := index[symbol.Name]
+		if !ok {
+			values = make(map[string]SymbolValue, len(symbol.Values))
+			index[symbol.Name] = values
+		}
+		for _, v := range symbol.Values {
+			code := StringOrFloat64(v.Code).SymbolCode()
+			if _, ok := values[code]; !ok {
+				values[code] = v
+			}
+		}
+	}
+	return index
+}
+
+// Resolve looks up the symbol value with the given code in the named category, like SymbolList.Resolve.
+func (idx SymbolIndex) Resolve(category string, code StringOrFloat64) (SymbolValue, bool) {
+	v, ok := idx[category][code.SymbolCode()]
+	return v, ok
+}
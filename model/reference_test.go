@@ -0,0 +1,181 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestSymbolValueIconFor verifies day/night icon selection relative to sunrise and sunset.
+func TestSymbolValueIconFor(t *testing.T) {
+	sunrise := time.Date(2020, 8, 20, 5, 0, 0, 0, time.UTC)
+	sunset := time.Date(2020, 8, 20, 19, 0, 0, 0, time.UTC)
+
+	value := SymbolValue{
+		Code:         "1",
+		IconURL:      "https://static-m.meteo.cat/assets-w3/images/meteors/estatcel/1.svg",
+		IconURLNight: "https://static-m.meteo.cat/assets-w3/images/meteors/estatcel/1n.svg",
+	}
+
+	testCases := []struct {
+		name     string
+		value    SymbolValue
+		at       time.Time
+		expected string
+	}{
+		{"noon", value, time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC), value.IconURL},
+		{"midnight", value, time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC), value.IconURLNight},
+		{"after sunset", value, time.Date(2020, 8, 20, 21, 0, 0, 0, time.UTC), value.IconURLNight},
+		{"no night icon", SymbolValue{Code: "2", IconURL: "day.svg"}, time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC), "day.svg"},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.value.IconFor(tc.at, sunrise, sunset); got != tc.expected {
+			t.Errorf("%s: expected icon %s, got %s", tc.name, tc.expected, got)
+		}
+	}
+}
+
+// TestSymbolListResolve verifies lookup by category with normalized codes.
+func TestSymbolListResolve(t *testing.T) {
+	symbols := SymbolList{
+		{Name: "cel", Values: []SymbolValue{{Code: "1", Name: "Cel serè"}, {Code: "3", Name: "Mig ennuvolat"}, {Code: "3a", Name: "Variant"}}},
+		{Name: "precipitacio", Values: []SymbolValue{{Code: "3", Name: "Pluja"}}},
+	}
+
+	testCases := []struct {
+		code     StringOrFloat64
+		expected string
+	}{
+		{"3", "Mig ennuvolat"},
+		{"3.0", "Mig ennuvolat"},
+		{"3a", "Variant"},
+	}
+	for _, tc := range testCases {
+		v, ok := symbols.Resolve("cel", tc.code)
+		if !ok || v.Name != tc.expected {
+			t.Errorf("Resolve(cel, %q): expected %q, got %q (ok=%v)", tc.code, tc.expected, v.Name, ok)
+		}
+	}
+
+	if _, ok := symbols.Resolve("cel", "99"); ok {
+		t.Error("expected unknown code not to resolve")
+	}
+	if _, ok := symbols.Resolve("vent", "1"); ok {
+		t.Error("expected unknown category not to resolve")
+	}
+}
+
+// TestSymbolListIndex verifies that index lookups match the linear Resolve.
+func TestSymbolListIndex(t *testing.T) {
+	symbols := SymbolList{
+		{Name: "cel", Values: []SymbolValue{{Code: "1", Name: "Cel serè"}, {Code: "3", Name: "Mig ennuvolat"}, {Code: "3.0", Name: "Duplicat"}, {Code: "3a", Name: "Variant"}}},
+		{Name: "precipitacio", Values: []SymbolValue{{Code: "3", Name: "Pluja"}}},
+	}
+
+	index := symbols.Index()
+	if len(index) != 2 || len(index["cel"]) != 3 || len(index["precipitacio"]) != 1 {
+		t.Fatalf("unexpected index %v", index)
+	}
+
+	for _, category := range []string{"cel", "precipitacio", "vent"} {
+		for _, code := range []StringOrFloat64{"1", "1.0", "3", "3.0", "3a", "99"} {
+			want, wantOK := symbols.Resolve(category, code)
+			got, gotOK := index.Resolve(category, code)
+			if got != want || gotOK != wantOK {
+				t.Errorf("Resolve(%s, %q): index returned %+v (ok=%v), list returned %+v (ok=%v)", category, code, got, gotOK, want, wantOK)
+			}
+		}
+	}
+}
+
+// TestRegionListByCode verifies lookups of present and absent codes, including the zero code.
+func TestRegionListByCode(t *testing.T) {
+	regions := RegionList{{Code: 13, Name: "Barcelonès"}, {Code: 14, Name: "Berguedà"}}
+
+	region, ok := regions.ByCode(14)
+	if !ok || region.Name != "Berguedà" {
+		t.Fatalf("expected Berguedà, got %+v (ok=%t)", region, ok)
+	}
+
+	region.Name = "modified"
+	if regions[1].Name != "Berguedà" {
+		t.Error("modifying the returned region must not affect the list")
+	}
+
+	for _, code := range []int{0, 99} {
+		if region, ok := regions.ByCode(code); ok || region != nil {
+			t.Errorf("code %d: expected no match, got %+v", code, region)
+		}
+	}
+
+	withZero := append(RegionList{{Code: 0, Name: "Desconeguda"}}, regions...)
+	if region, ok := withZero.ByCode(0); !ok || region.Name != "Desconeguda" {
+		t.Errorf("expected the zero-code region, got %+v (ok=%t)", region, ok)
+	}
+}
+
+// TestMunicipalityListNearest verifies that the closest municipality with usable coordinates is returned.
+func TestMunicipalityListNearest(t *testing.T) {
+	municipalities := MunicipalityList{
+		{Code: "000000", Name: "Sense coordenades"},
+		{Code: "999999", Name: "Zero", Coordinates: &Coordinates{}},
+		{Code: "170792", Name: "Girona", Coordinates: &Coordinates{Latitude: 41.9794, Longitude: 2.8214}},
+		{Code: "080193", Name: "Barcelona", Coordinates: &Coordinates{Latitude: 41.3874, Longitude: 2.1686}},
+	}
+
+	nearest, ok := municipalities.Nearest(Coordinates{Latitude: 41.45, Longitude: 2.25})
+	if !ok || nearest.Code != "080193" {
+		t.Fatalf("expected Barcelona, got %+v (ok=%t)", nearest, ok)
+	}
+
+	nearest.Name = "modified"
+	if municipalities[3].Name != "Barcelona" {
+		t.Error("modifying the returned municipality must not affect the list")
+	}
+
+	if nearest, ok := municipalities[:2].Nearest(Coordinates{Latitude: 41, Longitude: 2}); ok {
+		t.Errorf("expected no match without usable coordinates, got %+v", nearest)
+	}
+}
+
+// TestRegionUnmarshalJSON verifies that numeric and string codes decode alike and marshal as numbers.
+func TestRegionUnmarshalJSON(t *testing.T) {
+	for _, data := range []string{`{"codi":24,"nom":"Noguera"}`, `{"codi":"24","nom":"Noguera"}`} {
+		var region Region
+		if err := json.Unmarshal([]byte(data), &region); err != nil {
+			t.Fatalf("%s: unexpected error: %v", data, err)
+		}
+		if region.Code != 24 || region.Name != "Noguera" {
+			t.Errorf("%s: unexpected region %+v", data, region)
+		}
+
+		out, err := json.Marshal(region)
+		if err != nil || string(out) != `{"codi":24,"nom":"Noguera"}` {
+			t.Errorf("%s: expected numeric code when marshaling, got %s (%v)", data, out, err)
+		}
+	}
+
+	var region Region
+	if err := json.Unmarshal([]byte(`{"codi":"abc"}`), &region); err == nil {
+		t.Error("expected error for a non-numeric code")
+	}
+}
+
+// TestNormalizeMunicipalityCode verifies trimming and zero-padding of numeric codes.
+func TestNormalizeMunicipalityCode(t *testing.T) {
+	testCases := map[string]string{
+		"250019":   "250019",
+		" 250019 ": "250019",
+		"25019":    "025019",
+		"\t8019\n": "008019",
+		"ab12":     "ab12",
+		"1234567":  "1234567",
+		"":         "",
+	}
+	for code, expected := range testCases {
+		if got := NormalizeMunicipalityCode(code); got != expected {
+			t.Errorf("%q: expected %q, got %q", code, expected, got)
+		}
+	}
+}
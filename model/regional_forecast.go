@@ -0,0 +1,63 @@
+package model
+
+// DaySegment identifies the part of the day a regional forecast period refers to.
+type DaySegment string
+
+const (
+	// DaySegmentMorning is the morning period (matí).
+	DaySegmentMorning DaySegment = "mati"
+
+	// DaySegmentAfternoon is the afternoon period (tarda).
+	DaySegmentAfternoon DaySegment = "tarda"
+
+	// DaySegmentNight is the night period (nit).
+	DaySegmentNight DaySegment = "nit"
+)
+
+// TemperatureTrend describes the expected temperature evolution relative to the previous day.
+type TemperatureTrend string
+
+const (
+	// TemperatureTrendRising indicates temperatures going up (ascens).
+	TemperatureTrendRising TemperatureTrend = "ascens"
+
+	// TemperatureTrendFalling indicates temperatures going down (descens).
+	TemperatureTrendFalling TemperatureTrend = "descens"
+
+	// TemperatureTrendSteady indicates temperatures without significant change (sense canvis).
+	TemperatureTrendSteady TemperatureTrend = "sense canvis"
+)
+
+// RegionalForecastPeriod represents the forecast conditions for one segment of a day in a region.
+type RegionalForecastPeriod struct {
+	// Segment is the part of the day covered by this period (morning, afternoon or night)
+	Segment DaySegment `json:"franja"`
+
+	// Date is the day of the period in format "YYYY-MM-DDZ" (e.g., "2020-08-20Z")
+	Date string `json:"data"`
+
+	// SkyCode is the sky state symbol code, resolvable with the symbols metadata endpoint
+	SkyCode string `json:"estatCel"`
+
+	// TemperatureTrend is the expected temperature evolution
+	TemperatureTrend TemperatureTrend `json:"tendenciaTemperatura"`
+
+	// Description is the textual forecast for the period in Catalan
+	Description string `json:"descripcio"`
+}
+
+// RegionalForecast represents the textual forecast of a region (comarca),
+// split into morning, afternoon and night periods over its validity window.
+type RegionalForecast struct {
+	// Region is the region (comarca) the forecast refers to
+	Region Region `json:"comarca"`
+
+	// ValidFrom is the start of the validity window in UTC
+	ValidFrom MeteocatTime `json:"dataInici"`
+
+	// ValidTo is the end of the validity window in UTC
+	ValidTo MeteocatTime `json:"dataFi"`
+
+	// Periods lists the forecast conditions for each day segment
+	Periods []RegionalForecastPeriod `json:"franges"`
+}
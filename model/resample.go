@@ -0,0 +1,108 @@
+package model
+
+import (
+	"strconv"
+	"time"
+)
+
+// AggFunc selects how hourly values are combined when resampling into larger blocks.
+type AggFunc int
+
+const (
+	// AggMean averages the values of each block.
+	AggMean AggFunc = iota
+
+	// AggMax keeps the largest value of each block.
+	AggMax
+
+	// AggMin keeps the smallest value of each block.
+	AggMin
+
+	// AggFirst keeps the earliest value of each block.
+	AggFirst
+
+	// AggSum adds the values of each block; it suits accumulated variables such as precipitation.
+	AggSum
+)
+
+// Resample groups the temperature values into consecutive blocks of the given duration (e.g., 3h)
+// and aggregates each block into a single value stamped with the block's start time.
+// See resampleHourly for the grouping rules.
+func (t Temperature) Resample(block time.Duration, agg AggFunc) []HourlyValue {
+	return resampleHourly(t.Values, block, agg)
+}
+
+// Resample groups the precipitation values into consecutive blocks of the given duration (e.g., 3h)
+// and aggregates each block into a single value stamped with the block's start time.
+// AggSum gives the accumulated precipitation of each block. See resampleHourly for the grouping rules.
+func (p Precipitation) Resample(block time.Duration, agg AggFunc) []HourlyValue {
+	return resampleHourly(p.Values, block, agg)
+}
+
+// resampleHourly aggregates values into consecutive blocks anchored at the first value's time
+// (a series starting at 14:00 gives 3h blocks starting at 14:00, 17:00, ...), stamped in UTC.
+// Values are expected in chronological order.
+// Missing and other non-numeric values are ignored, and blocks without any numeric value are omitted.
+// It returns nil when block is not positive.
+func resampleHourly(values []HourlyValue, block time.Duration, agg AggFunc) []HourlyValue {
+	if block <= 0 || len(values) == 0 {
+		return nil
+	}
+
+	var out []HourlyValue
+	var start time.Time
+	var bucket []float64
+
+	flush := func() {
+		if len(bucket) == 0 {
+			return
+		}
+		value := aggregate(bucket, agg)
+		out = append(out, HourlyValue{
+			Value: StringOrFloat64(strconv.FormatFloat(value, 'f', -1, 64)),
+			Time:  MeteocatTime{Time: start},
+		})
+		bucket = bucket[:0]
+	}
+
+	anchor := values[0].Time.UTC()
+	for _, v := range values {
+		f, err := v.Value.Float64()
+		if err != nil {
+			continue
+		}
+		blockStart := anchor.Add(v.Time.Sub(anchor).Truncate(block))
+		if !blockStart.Equal(start) {
+			flush()
+			start = blockStart
+		}
+		bucket = append(bucket, f)
+	}
+	flush()
+
+	return out
+}
+
+// aggregate combines a non-empty list of values with agg.
+func aggregate(values []float64, agg AggFunc) float64 {
+	result := values[0]
+	switch agg {
+	case AggMax:
+		for _, v := range values[1:] {
+			result = max(result, v)
+		}
+	case AggMin:
+		for _, v := range values[1:] {
+			result = min(result, v)
+		}
+	case AggFirst:
+	case AggSum, AggMean:
+		for _, v := range values[1:] {
+			result += v
+		}
+		if agg == AggMean {
+			result /= float64(len(values))
+		}
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// newHourlySeries builds consecutive hourly values starting at midnight UTC.
+func newHourlySeries(values ...StringOrFloat64) []HourlyValue {
+	return newHourlySeriesAt(0, values...)
+}
+
+// newHourlySeriesAt builds consecutive hourly values starting at the given UTC hour.
+func newHourlySeriesAt(hour int, values ...StringOrFloat64) []HourlyValue {
+	series := make([]HourlyValue, len(values))
+	for i, v := range values {
+		series[i] = HourlyValue{Value: v, Time: MeteocatTime{Time: time.Date(2020, 8, 20, hour+i, 0, 0, 0, time.UTC)}}
+	}
+	return series
+}
+
+// TestTemperatureResample verifies 3-hour blocks over a 6-hour series.
+func TestTemperatureResample(t *testing.T) {
+	temperature := Temperature{Values: newHourlySeries("15", "18", "21", "20", "24", "22")}
+
+	testCases := []struct {
+		agg      AggFunc
+		expected []StringOrFloat64
+	}{
+		{AggMean, []StringOrFloat64{"18", "22"}},
+		{AggMax, []StringOrFloat64{"21", "24"}},
+		{AggMin, []StringOrFloat64{"15", "20"}},
+		{AggFirst, []StringOrFloat64{"15", "20"}},
+	}
+
+	for _, tc := range testCases {
+		blocks := temperature.Resample(3*time.Hour, tc.agg)
+		if len(blocks) != len(tc.expected) {
+			t.Fatalf("agg %d: expected %d blocks, got %d", tc.agg, len(tc.expected), len(blocks))
+		}
+		for i, want := range tc.expected {
+			if blocks[i].Value != want {
+				t.Errorf("agg %d block %d: expected %s, got %s", tc.agg, i, want, blocks[i].Value)
+			}
+		}
+		if !blocks[1].Time.Time.Equal(time.Date(2020, 8, 20, 3, 0, 0, 0, time.UTC)) {
+			t.Errorf("agg %d: expected second block at 03:00, got %v", tc.agg, blocks[1].Time)
+		}
+	}
+}
+
+// TestPrecipitationResample verifies summing and skipping of non-numeric values.
+func TestPrecipitationResample(t *testing.T) {
+	precipitation := Precipitation{Values: newHourlySeries("0.5", "n/a", "1.5", "0", "0", "0.2")}
+
+	blocks := precipitation.Resample(3*time.Hour, AggSum)
+	if len(blocks) != 2 || blocks[0].Value != "2" || blocks[1].Value != "0.2" {
+		t.Errorf("unexpected blocks %+v", blocks)
+	}
+
+	if blocks := precipitation.Resample(0, AggSum); blocks != nil {
+		t.Errorf("expected nil for a non-positive block, got %+v", blocks)
+	}
+}
+
+// TestResample_UnalignedStart verifies that blocks start at the first value rather than at a UTC
+// multiple of the block duration.
+func TestResample_UnalignedStart(t *testing.T) {
+	temperature := Temperature{Values: newHourlySeriesAt(14, "15", "18", "21", "20")}
+
+	blocks := temperature.Resample(3*time.Hour, AggMean)
+	if len(blocks) != 2 || blocks[0].Value != "18" || blocks[1].Value != "20" {
+		t.Fatalf("unexpected blocks %+v", blocks)
+	}
+	for i, hour := range []int{14, 17} {
+		if !blocks[i].Time.Time.Equal(time.Date(2020, 8, 20, hour, 0, 0, 0, time.UTC)) {
+			t.Errorf("block %d: expected start at %02d:00, got %v", i, hour, blocks[i].Time)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// sunriseZenith is the solar zenith angle in degrees at sunrise and sunset, accounting for
+// atmospheric refraction and the size of the solar disk.
+const sunriseZenith = 90.833
+
+// SunriseSunset computes the UTC sunrise and sunset times for the given day at coord using the
+// NOAA general solar position equations. Only the calendar date of day (in UTC) is used.
+// The result is accurate to within a couple of minutes at mid latitudes.
+//
+// ok is false when the sun does not rise or set that day (polar day or polar night), or when
+// the coordinates are invalid; the returned times are zero in that case.
+func SunriseSunset(day time.Time, coord Coordinates) (sunrise, sunset time.Time, ok bool) {
+	if !coord.Valid() {
+		return time.Time{}, time.Time{}, false
+	}
+
+	day = day.UTC()
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+	// Fractional year (radians) evaluated at solar noon.
+	gamma := 2 * math.Pi / daysInYear(day.Year()) * float64(day.YearDay()-1)
+
+	eqTime := 229.18 * (0.000075 +
+		0.001868*math.Cos(gamma) - 0.032077*math.Sin(gamma) -
+		0.014615*math.Cos(2*gamma) - 0.040849*math.Sin(2*gamma))
+
+	decl := 0.006918 -
+		0.399912*math.Cos(gamma) + 0.070257*math.Sin(gamma) -
+		0.006758*math.Cos(2*gamma) + 0.000907*math.Sin(2*gamma) -
+		0.002697*math.Cos(3*gamma) + 0.00148*math.Sin(3*gamma)
+
+	lat := coord.Latitude * math.Pi / 180
+	cosHA := math.Cos(sunriseZenith*math.Pi/180)/(math.Cos(lat)*math.Cos(decl)) - math.Tan(lat)*math.Tan(decl)
+	if cosHA < -1 || cosHA > 1 {
+		return time.Time{}, time.Time{}, false
+	}
+	ha := math.Acos(cosHA) * 180 / math.Pi
+
+	sunriseMinutes := 720 - 4*(coord.Longitude+ha) - eqTime
+	sunsetMinutes := 720 - 4*(coord.Longitude-ha) - eqTime
+
+	sunrise = midnight.Add(time.Duration(sunriseMinutes * float64(time.Minute))).Round(time.Second)
+	sunset = midnight.Add(time.Duration(sunsetMinutes * float64(time.Minute))).Round(time.Second)
+	return sunrise, sunset, true
+}
+
+// daysInYear returns the number of days in year.
+func daysInYear(year int) float64 {
+	if time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() == 366 {
+		return 366
+	}
+	return 365
+}
@@ -0,0 +1,93 @@
+package model
+
+import "time"
+
+// SolarSkyValue is an hourly sky condition value tagged with whether it falls in daylight.
+type SolarSkyValue struct {
+	HourlyValue
+
+	// IsDay reports whether Time lies between sunrise and sunset at the forecast location.
+	// It is false when the sun does not rise that day.
+	IsDay bool
+}
+
+// SolarForecastDay is a forecast day enriched with its solar event times.
+type SolarForecastDay struct {
+	ForecastDay
+
+	// Sunrise and Sunset are the UTC solar event times for the day.
+	// They are zero when HasSolarEvents is false.
+	Sunrise time.Time
+	Sunset  time.Time
+
+	// HasSolarEvents is false when the day's date cannot be parsed or the sun does not
+	// rise or set that day (polar day or night).
+	HasSolarEvents bool
+
+	// Sky holds the day's hourly sky condition values tagged as day or night.
+	Sky []SolarSkyValue
+}
+
+// SolarEnrichedForecast is a municipal hourly forecast with sunrise/sunset information,
+// suitable for choosing day or night sky condition icons.
+type SolarEnrichedForecast struct {
+	// MunicipalityCode is the unique 6-digit identifier for the municipality
+	MunicipalityCode string
+
+	// Coordinates is the location used for the solar calculations
+	Coordinates Coordinates
+
+	// IssuedAt is the time the forecast was generated, when the API provides it
+	IssuedAt *MeteocatTime
+
+	// Days contains the enriched forecast days, in the same order as the source forecast
+	Days []SolarForecastDay
+}
+
+// WithSolar computes sunrise and sunset for each forecast day at coord and tags each hourly sky
+// value as day or night. Each sky value is classified against the solar events of its own UTC date.
+func (f MunicipalityHourlyForecast) WithSolar(coord Coordinates) SolarEnrichedForecast {
+	out := SolarEnrichedForecast{
+		MunicipalityCode: f.MunicipalityCode,
+		Coordinates:      coord,
+		IssuedAt:         f.IssuedAt,
+		Days:             make([]SolarForecastDay, 0, len(f.Days)),
+	}
+
+	type solarEvents struct {
+		sunrise, sunset time.Time
+		ok              bool
+	}
+	cache := make(map[time.Time]solarEvents)
+	eventsFor := func(t time.Time) solarEvents {
+		t = t.UTC()
+		key := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		ev, found := cache[key]
+		if !found {
+			ev.sunrise, ev.sunset, ev.ok = SunriseSunset(key, coord)
+			cache[key] = ev
+		}
+		return ev
+	}
+
+	for _, day := range f.Days {
+		enriched := SolarForecastDay{ForecastDay: day}
+		if date, err := day.ParsedDate(); err == nil {
+			ev := eventsFor(date)
+			enriched.Sunrise, enriched.Sunset, enriched.HasSolarEvents = ev.sunrise, ev.sunset, ev.ok
+		}
+
+		if day.Variables != nil && day.Variables.SkyConditions != nil {
+			values := day.Variables.SkyConditions.Values
+			enriched.Sky = make([]SolarSkyValue, 0, len(values))
+			for _, v := range values {
+				ev := eventsFor(v.Time.Time)
+				isDay := ev.ok && !v.Time.Before(ev.sunrise) && v.Time.Before(ev.sunset)
+				enriched.Sky = append(enriched.Sky, SolarSkyValue{HourlyValue: v, IsDay: isDay})
+			}
+		}
+
+		out.Days = append(out.Days, enriched)
+	}
+	return out
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// TestMunicipalityHourlyForecastWithSolar verifies day/night tagging of sky values.
+func TestMunicipalityHourlyForecastWithSolar(t *testing.T) {
+	forecast := newTestForecast()
+	forecast.Days[0].Variables.SkyConditions = &SkyConditions{
+		Values: []HourlyValue{
+			{Value: "21", Time: MeteocatTime{Time: time.Date(2020, 8, 20, 3, 0, 0, 0, time.UTC)}},
+			{Value: "1", Time: MeteocatTime{Time: time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC)}},
+			{Value: "21", Time: MeteocatTime{Time: time.Date(2020, 8, 20, 20, 0, 0, 0, time.UTC)}},
+		},
+	}
+
+	enriched := forecast.WithSolar(barcelona)
+
+	if enriched.MunicipalityCode != "250019" {
+		t.Errorf("expected municipality code 250019, got %s", enriched.MunicipalityCode)
+	}
+	if len(enriched.Days) != 2 {
+		t.Fatalf("expected 2 days, got %d", len(enriched.Days))
+	}
+
+	day := enriched.Days[0]
+	if !day.HasSolarEvents {
+		t.Fatal("expected solar events for Barcelona in August")
+	}
+	if !day.Sunrise.Before(day.Sunset) {
+		t.Errorf("expected sunrise %s before sunset %s", day.Sunrise, day.Sunset)
+	}
+
+	expected := []bool{false, true, false}
+	if len(day.Sky) != len(expected) {
+		t.Fatalf("expected %d sky values, got %d", len(expected), len(day.Sky))
+	}
+	for i, want := range expected {
+		if day.Sky[i].IsDay != want {
+			t.Errorf("sky value at %s: expected IsDay=%v, got %v", day.Sky[i].Time.Format("15:04"), want, day.Sky[i].IsDay)
+		}
+	}
+
+	if enriched.Days[1].Sky != nil {
+		t.Errorf("expected no sky values for a day without sky conditions, got %v", enriched.Days[1].Sky)
+	}
+}
+
+// TestMunicipalityHourlyForecastWithSolar_MissingDate verifies that missing dates leave solar events unset.
+func TestMunicipalityHourlyForecastWithSolar_MissingDate(t *testing.T) {
+	forecast := MunicipalityHourlyForecast{Days: []ForecastDay{{}}}
+
+	enriched := forecast.WithSolar(barcelona)
+	if enriched.Days[0].HasSolarEvents {
+		t.Error("expected HasSolarEvents=false for a missing date")
+	}
+}
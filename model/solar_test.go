@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+// barcelona is the approximate center of Barcelona.
+var barcelona = Coordinates{Latitude: 41.3874, Longitude: 2.1686}
+
+// TestSunriseSunset_Barcelona verifies solar event times against published Barcelona values.
+func TestSunriseSunset_Barcelona(t *testing.T) {
+	testCases := []struct {
+		name    string
+		day     time.Time
+		sunrise time.Time
+		sunset  time.Time
+	}{
+		{
+			// Summer solstice: 06:17 and 21:29 local time (CEST, UTC+2).
+			name:    "summer solstice",
+			day:     time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC),
+			sunrise: time.Date(2024, 6, 21, 4, 17, 0, 0, time.UTC),
+			sunset:  time.Date(2024, 6, 21, 19, 29, 0, 0, time.UTC),
+		},
+		{
+			// Winter solstice: 08:13 and 17:26 local time (CET, UTC+1).
+			name:    "winter solstice",
+			day:     time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC),
+			sunrise: time.Date(2024, 12, 21, 7, 13, 0, 0, time.UTC),
+			sunset:  time.Date(2024, 12, 21, 16, 26, 0, 0, time.UTC),
+		},
+		{
+			// Spring equinox: 06:56 and 19:04 local time (CET, UTC+1).
+			name:    "spring equinox",
+			day:     time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC),
+			sunrise: time.Date(2024, 3, 20, 5, 56, 0, 0, time.UTC),
+			sunset:  time.Date(2024, 3, 20, 18, 4, 0, 0, time.UTC),
+		},
+	}
+
+	const tolerance = 3 * time.Minute
+	for _, tc := range testCases {
+		sunrise, sunset, ok := SunriseSunset(tc.day, barcelona)
+		if !ok {
+			t.Fatalf("%s: expected solar events, got ok=false", tc.name)
+		}
+		if diff := sunrise.Sub(tc.sunrise); diff > tolerance || diff < -tolerance {
+			t.Errorf("%s: expected sunrise near %s, got %s", tc.name, tc.sunrise.Format("15:04"), sunrise.Format("15:04:05"))
+		}
+		if diff := sunset.Sub(tc.sunset); diff > tolerance || diff < -tolerance {
+			t.Errorf("%s: expected sunset near %s, got %s", tc.name, tc.sunset.Format("15:04"), sunset.Format("15:04:05"))
+		}
+	}
+}
+
+// TestSunriseSunset_Polar verifies that polar day and invalid coordinates report ok=false.
+func TestSunriseSunset_Polar(t *testing.T) {
+	svalbard := Coordinates{Latitude: 78.22, Longitude: 15.65}
+	if _, _, ok := SunriseSunset(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), svalbard); ok {
+		t.Error("expected ok=false during polar day")
+	}
+	if _, _, ok := SunriseSunset(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), Coordinates{Latitude: 120}); ok {
+		t.Error("expected ok=false for invalid coordinates")
+	}
+}
@@ -0,0 +1,72 @@
+package model
+
+import "encoding/json"
+
+// UVCategory is the health risk category associated with a UV index value (WHO scale).
+type UVCategory string
+
+const (
+	// UVCategoryLow covers UV index values below 3.
+	UVCategoryLow UVCategory = "low"
+
+	// UVCategoryModerate covers UV index values from 3 up to (excluding) 6.
+	UVCategoryModerate UVCategory = "moderate"
+
+	// UVCategoryHigh covers UV index values from 6 up to (excluding) 8.
+	UVCategoryHigh UVCategory = "high"
+
+	// UVCategoryVeryHigh covers UV index values from 8 up to (excluding) 11.
+	UVCategoryVeryHigh UVCategory = "very high"
+
+	// UVCategoryExtreme covers UV index values of 11 and above.
+	UVCategoryExtreme UVCategory = "extreme"
+)
+
+// UVRisk returns the risk category for a UV index value.
+func UVRisk(index float64) UVCategory {
+	switch {
+	case index < 3:
+		return UVCategoryLow
+	case index < 6:
+		return UVCategoryModerate
+	case index < 8:
+		return UVCategoryHigh
+	case index < 11:
+		return UVCategoryVeryHigh
+	default:
+		return UVCategoryExtreme
+	}
+}
+
+// UVIndexDay is the UV index forecast for a single day.
+type UVIndexDay struct {
+	// Date is the forecast day in format "YYYY-MM-DDZ" (e.g., "2020-08-20Z")
+	Date string `json:"data"`
+
+	// MaxIndex is the maximum UV index expected during the day
+	MaxIndex float64 `json:"uviMax"`
+
+	// Risk is the risk category of MaxIndex, derived with UVRisk when decoding
+	Risk UVCategory `json:"-"`
+}
+
+// UnmarshalJSON decodes a UV index day and derives its risk category from MaxIndex.
+func (d *UVIndexDay) UnmarshalJSON(data []byte) error {
+	type rawDay UVIndexDay
+	var raw rawDay
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*d = UVIndexDay(raw)
+	d.Risk = UVRisk(d.MaxIndex)
+	return nil
+}
+
+// UVIndexForecast is the UV index forecast for a municipality.
+type UVIndexForecast struct {
+	// MunicipalityCode is the unique 6-digit identifier for the municipality (e.g., "080193")
+	MunicipalityCode string `json:"codiMunicipi"`
+
+	// Days contains the UV index forecast for each day
+	Days []UVIndexDay `json:"dies"`
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestUVRisk verifies the category mapping at every threshold boundary.
+func TestUVRisk(t *testing.T) {
+	testCases := []struct {
+		index    float64
+		expected UVCategory
+	}{
+		{0, UVCategoryLow},
+		{2.9, UVCategoryLow},
+		{3, UVCategoryModerate},
+		{5.9, UVCategoryModerate},
+		{6, UVCategoryHigh},
+		{7.9, UVCategoryHigh},
+		{8, UVCategoryVeryHigh},
+		{10.9, UVCategoryVeryHigh},
+		{11, UVCategoryExtreme},
+		{14, UVCategoryExtreme},
+	}
+	for _, tc := range testCases {
+		if got := UVRisk(tc.index); got != tc.expected {
+			t.Errorf("UVRisk(%v): expected %q, got %q", tc.index, tc.expected, got)
+		}
+	}
+}
+
+// TestUVIndexDayUnmarshalJSON verifies that the risk category is derived when decoding.
+func TestUVIndexDayUnmarshalJSON(t *testing.T) {
+	var day UVIndexDay
+	if err := json.Unmarshal([]byte(`{"data":"2020-08-20Z","uviMax":8.2}`), &day); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if day.Date != "2020-08-20Z" || day.MaxIndex != 8.2 || day.Risk != UVCategoryVeryHigh {
+		t.Errorf("unexpected day %+v", day)
+	}
+}
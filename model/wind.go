@@ -0,0 +1,41 @@
+package model
+
+import "math"
+
+// MeanWind returns the day's vector-mean wind: each hour's speed (km/h) and direction (degrees) are
+// converted to u/v components, averaged and converted back, so directions around north average
+// correctly (350° and 10° give 0°, not 180°). The direction is in [0, 360), with the API's convention
+// of where the wind blows from; opposing winds partly cancel out and lower the mean speed. Values are
+// matched by hour and hours lacking a numeric speed or direction are skipped. It reports false when
+// either variable is absent or no hour has both values.
+func (d ForecastDay) MeanWind() (speedKmh, directionDeg float64, ok bool) {
+	v := d.Variables
+	if v == nil || v.WindSpeed == nil || v.WindDirection == nil {
+		return 0, 0, false
+	}
+
+	directions := numericByTime(v.WindDirection.Values)
+	var sumU, sumV float64
+	n := 0
+	for _, s := range v.WindSpeed.Values {
+		speed, err := s.Value.Float64()
+		if err != nil {
+			continue
+		}
+		direction, found := directions[s.Time.Unix()]
+		if !found {
+			continue
+		}
+		rad := direction * math.Pi / 180
+		sumU += speed * math.Sin(rad)
+		sumV += speed * math.Cos(rad)
+		n++
+	}
+	if n == 0 {
+		return 0, 0, false
+	}
+
+	u, w := sumU/float64(n), sumV/float64(n)
+	directionDeg = math.Mod(math.Atan2(u, w)*180/math.Pi+360, 360)
+	return math.Hypot(u, w), directionDeg, true
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestForecastDayMeanWind verifies the vector mean across north and the missing data cases.
+func TestForecastDayMeanWind(t *testing.T) {
+	at := func(hour int) MeteocatTime {
+		return MeteocatTime{Time: time.Date(2020, 1, 20, hour, 0, 0, 0, time.UTC)}
+	}
+	day := ForecastDay{
+		Date: mustParseDate("2020-01-20Z"),
+		Variables: &ForecastVariables{
+			WindSpeed:     &WindSpeed{Values: []HourlyValue{{Value: "10", Time: at(0)}, {Value: "10", Time: at(1)}, {Value: "30", Time: at(2)}}},
+			WindDirection: &WindDirection{Values: []HourlyValue{{Value: "350", Time: at(0)}, {Value: "10", Time: at(1)}, {Value: "", Time: at(2)}}},
+		},
+	}
+
+	speed, direction, ok := day.MeanWind()
+	if !ok {
+		t.Fatal("expected a mean wind")
+	}
+	if math.Min(direction, 360-direction) > 1e-9 {
+		t.Errorf("expected a direction near 0°, got %.4f", direction)
+	}
+	if expected := 10 * math.Cos(10*math.Pi/180); math.Abs(speed-expected) > 1e-9 {
+		t.Errorf("expected speed %.4f, got %.4f", expected, speed)
+	}
+
+	day.Variables.WindDirection.Values = []HourlyValue{{Value: "90", Time: at(0)}, {Value: "180", Time: at(1)}}
+	if _, direction, _ := day.MeanWind(); math.Abs(direction-135) > 1e-9 {
+		t.Errorf("expected 135°, got %.4f", direction)
+	}
+
+	day.Variables.WindDirection.Values = []HourlyValue{{Value: "90", Time: at(5)}}
+	if _, _, ok := day.MeanWind(); ok {
+		t.Error("expected ok=false without matching hours")
+	}
+	day.Variables.WindDirection = nil
+	if _, _, ok := day.MeanWind(); ok {
+		t.Error("expected ok=false without wind direction")
+	}
+}
@@ -0,0 +1,41 @@
+package model
+
+// DailyExtreme is an extreme value reached by a variable during the day, with the time it occurred.
+type DailyExtreme struct {
+	// Value is the extreme value, expressed in the variable's unit
+	Value float64 `json:"valor"`
+
+	// Time is the timestamp (in UTC) at which the extreme was recorded
+	Time MeteocatTime `json:"data"`
+}
+
+// DailyVariableStats holds the daily aggregates of a single variable measured at a station.
+// Aggregates that do not apply to the variable (e.g., an accumulated temperature) are nil.
+type DailyVariableStats struct {
+	// Code is the unique numeric identifier of the variable
+	Code int `json:"codi"`
+
+	// Max is the daily maximum and the time it was reached
+	Max *DailyExtreme `json:"maxim,omitempty"`
+
+	// Min is the daily minimum and the time it was reached
+	Min *DailyExtreme `json:"minim,omitempty"`
+
+	// Mean is the daily mean value
+	Mean *float64 `json:"mitjana,omitempty"`
+
+	// Accumulated is the daily accumulated value (e.g., precipitation in mm)
+	Accumulated *float64 `json:"acumulat,omitempty"`
+}
+
+// StationDailyStats is the daily statistical summary (resum) of the variables measured at a station.
+type StationDailyStats struct {
+	// StationCode is the unique identifier of the station (e.g., "CC")
+	StationCode string `json:"codiEstacio"`
+
+	// Date is the summarized day in format "YYYY-MM-DDZ" (e.g., "2020-06-16Z")
+	Date string `json:"data"`
+
+	// Variables holds the daily aggregates of each variable measured at the station
+	Variables []DailyVariableStats `json:"variables"`
+}
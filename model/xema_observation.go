@@ -1,5 +1,14 @@
 package model
 
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
 // Variable represents the metadata of a single XEMA variable.
 // Variables are the fundamental units used to record observations from stations,
 // such as atmospheric pressure, temperature, humidity, wind speed, etc.
@@ -28,6 +37,16 @@ type Variable struct {
 // VariableList represents a collection of variable metadata returned by the METEOCAT API.
 type VariableList []Variable
 
+// DecimalsByCode maps each variable code to its number of decimals, in the form expected by
+// StationObservationList.WriteCSV.
+func (l VariableList) DecimalsByCode() map[int]int {
+	decimals := make(map[int]int, len(l))
+	for _, v := range l {
+		decimals[v.Code] = v.Decimals
+	}
+	return decimals
+}
+
 // Reading represents a single observation measurement at a specific point in time.
 // Each reading includes a measured value, timestamp, validation status, and time base.
 type Reading struct {
@@ -59,6 +78,83 @@ type Reading struct {
 	TimeBase string `json:"baseHoraria"`
 }
 
+// Format formats the reading's value rounded to decimals places (see Variable.Decimals),
+// avoiding misleading trailing digits from the float64 representation. A negative decimals
+// uses the shortest representation that round-trips the value.
+func (r Reading) Format(decimals int) string {
+	if decimals < 0 {
+		decimals = -1
+	}
+	return strconv.FormatFloat(r.Value, 'f', decimals, 64)
+}
+
+// ReadingStatus is the quality control validation state of a reading (see Reading.Status).
+type ReadingStatus string
+
+const (
+	// StatusPending indicates the validation process has not started (blank status).
+	StatusPending ReadingStatus = ""
+
+	// StatusValidationStarted indicates the validation process started but its result is pending.
+	StatusValidationStarted ReadingStatus = "T"
+
+	// StatusValid indicates the reading is considered valid.
+	StatusValid ReadingStatus = "V"
+
+	// StatusInvalid indicates the reading is considered invalid.
+	StatusInvalid ReadingStatus = "N"
+)
+
+// StatusEnum returns the reading's Status as a ReadingStatus.
+// Values outside the documented set are returned unchanged, so they compare unequal to every constant.
+func (r Reading) StatusEnum() ReadingStatus {
+	return ReadingStatus(r.Status)
+}
+
+// IsValid reports whether the reading passed quality control validation (status "V").
+func (r Reading) IsValid() bool {
+	return r.StatusEnum() == StatusValid
+}
+
+// TimeBase is the measurement cadence code of a reading (see Reading.TimeBase).
+type TimeBase string
+
+const (
+	// TimeBaseHourly is an hourly measurement (Horària).
+	TimeBaseHourly TimeBase = "HO"
+
+	// TimeBaseSemiHourly is a 30-minute measurement (Semi-horària).
+	TimeBaseSemiHourly TimeBase = "SH"
+
+	// TimeBaseTenMinutes is a 10-minute measurement (10 minutal).
+	TimeBaseTenMinutes TimeBase = "DM"
+
+	// TimeBaseMinute is a 1-minute measurement (Minutal).
+	TimeBaseMinute TimeBase = "MI"
+)
+
+// Interval returns the cadence implied by the time base: 1h, 30m, 10m or 1m.
+// It reports false for unknown codes.
+func (tb TimeBase) Interval() (time.Duration, bool) {
+	switch tb {
+	case TimeBaseHourly:
+		return time.Hour, true
+	case TimeBaseSemiHourly:
+		return 30 * time.Minute, true
+	case TimeBaseTenMinutes:
+		return 10 * time.Minute, true
+	case TimeBaseMinute:
+		return time.Minute, true
+	default:
+		return 0, false
+	}
+}
+
+// Interval returns the cadence implied by the reading's TimeBase. It reports false for unknown codes.
+func (r Reading) Interval() (time.Duration, bool) {
+	return TimeBase(r.TimeBase).Interval()
+}
+
 // VariableObservation groups all readings for a single variable measured at a station.
 type VariableObservation struct {
 	// Code is the unique numeric identifier of the variable
@@ -79,3 +175,382 @@ type StationObservation struct {
 
 // StationObservationList represents a collection of observations returned by the METEOCAT API.
 type StationObservationList []StationObservation
+
+// Merge combines two observation lists into a single per-station, per-variable timeline.
+// Readings for matching (station code, variable code) pairs are concatenated and sorted by Data;
+// readings sharing an identical timestamp are deduplicated, keeping the one from the receiver.
+// Stations and variables keep the order in which they first appear. Neither input is modified.
+func (l StationObservationList) Merge(other StationObservationList) StationObservationList {
+	merged := make(StationObservationList, 0, len(l)+len(other))
+	stationIndex := make(map[string]int)
+	variableIndex := make(map[string]map[int]int)
+
+	for _, list := range []StationObservationList{l, other} {
+		for _, station := range list {
+			si, ok := stationIndex[station.Code]
+			if !ok {
+				si = len(merged)
+				stationIndex[station.Code] = si
+				variableIndex[station.Code] = make(map[int]int)
+				merged = append(merged, StationObservation{Code: station.Code})
+			}
+
+			for _, variable := range station.Variables {
+				vi, ok := variableIndex[station.Code][variable.Code]
+				if !ok {
+					vi = len(merged[si].Variables)
+					variableIndex[station.Code][variable.Code] = vi
+					merged[si].Variables = append(merged[si].Variables, VariableObservation{Code: variable.Code})
+				}
+				target := &merged[si].Variables[vi]
+				target.Readings = append(target.Readings, variable.Readings...)
+			}
+		}
+	}
+
+	for si := range merged {
+		for vi := range merged[si].Variables {
+			variable := &merged[si].Variables[vi]
+			variable.Readings = sortAndDedupReadings(variable.Readings)
+		}
+	}
+
+	return merged
+}
+
+// FilterTimeBase returns a copy of the list keeping only the readings measured with time base tb.
+// Variables left without readings are dropped; stations are kept even when no variable remains.
+func (l StationObservationList) FilterTimeBase(tb TimeBase) StationObservationList {
+	if l == nil {
+		return nil
+	}
+
+	out := make(StationObservationList, len(l))
+	for i, station := range l {
+		station.Variables = nil
+		for _, v := range l[i].Variables {
+			var readings []Reading
+			for _, r := range v.Readings {
+				if TimeBase(r.TimeBase) == tb {
+					readings = append(readings, r)
+				}
+			}
+			if len(readings) > 0 {
+				v.Readings = readings
+				station.Variables = append(station.Variables, v)
+			}
+		}
+		out[i] = station
+	}
+	return out
+}
+
+// ByStation indexes the observations by station code. If the list repeats a station code the
+// last entry wins; use Merge first to combine repeated stations.
+func (l StationObservationList) ByStation() map[string]StationObservation {
+	out := make(map[string]StationObservation, len(l))
+	for _, o := range l {
+		out[o.Code] = o
+	}
+	return out
+}
+
+// Single returns the only observation of the list, as produced when observations are requested
+// for a single station. It reports false when the list is empty or holds more than one observation.
+// The returned observation is a copy of the element.
+func (l StationObservationList) Single() (*StationObservation, bool) {
+	if len(l) != 1 {
+		return nil, false
+	}
+	o := l[0]
+	return &o, true
+}
+
+// EachReading calls fn for every reading in the list, in order, together with the station
+// and variable it belongs to. Iteration stops as soon as fn returns false.
+func (l StationObservationList) EachReading(fn func(stationCode string, variableCode int, r Reading) bool) {
+	for _, station := range l {
+		for _, variable := range station.Variables {
+			for _, r := range variable.Readings {
+				if !fn(station.Code, variable.Code, r) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ValidateDay returns the readings whose Data falls outside the UTC day of date, the day requested
+// from the observations endpoint, so callers can detect and discard readings leaking in from an
+// adjacent day. Readings are returned in the order EachReading visits them; nil means all are valid.
+func (l StationObservationList) ValidateDay(date time.Time) []Reading {
+	utc := date.UTC()
+	start := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	var outside []Reading
+	l.EachReading(func(_ string, _ int, r Reading) bool {
+		if t := r.Data.UTC(); t.Before(start) || !t.Before(end) {
+			outside = append(outside, r)
+		}
+		return true
+	})
+	return outside
+}
+
+// Between returns the readings whose Data falls in the half-open window [start, end).
+// Times are compared in UTC, matching how observation timestamps are stored.
+// It returns nil when no reading falls in the window.
+func (v VariableObservation) Between(start, end time.Time) []Reading {
+	start, end = start.UTC(), end.UTC()
+
+	var out []Reading
+	for _, r := range v.Readings {
+		t := r.Data.UTC()
+		if !t.Before(start) && t.Before(end) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// NearestTo returns the reading closest in time to target, e.g. the conditions "at 14:00" when no
+// reading falls exactly on that time. Invalid readings (status "N") are ignored and ties resolve to
+// the earlier reading. Readings need not be sorted. It reports false when there is no eligible reading.
+// The returned reading is a copy; modifying it does not affect v.
+func (v VariableObservation) NearestTo(target time.Time) (*Reading, bool) {
+	var nearest *Reading
+	var best time.Duration
+	for _, r := range v.Readings {
+		if r.StatusEnum() == StatusInvalid {
+			continue
+		}
+		diff := r.Data.Sub(target).Abs()
+		if nearest == nil || diff < best || (diff == best && r.Data.Before(nearest.Data.Time)) {
+			nearest, best = &r, diff
+		}
+	}
+	if nearest == nil {
+		return nil, false
+	}
+	out := *nearest
+	return &out, true
+}
+
+// TimedValue is a single point of an observation time series, e.g. for charting libraries.
+type TimedValue struct {
+	// Time is the timestamp (in UTC) of the reading
+	Time time.Time
+
+	// Value is the measured value
+	Value float64
+
+	// Valid reports whether the reading passed quality control validation (see Reading.IsValid)
+	Valid bool
+}
+
+// Series returns the variable's readings as a time series sorted by time, the observation
+// counterpart of ForecastDay.HourlyTimeline. Readings with equal timestamps keep their order.
+// It returns nil when the variable has no readings.
+func (v VariableObservation) Series() []TimedValue {
+	if len(v.Readings) == 0 {
+		return nil
+	}
+	out := make([]TimedValue, len(v.Readings))
+	for i, r := range v.Readings {
+		out[i] = TimedValue{Time: r.Data.UTC(), Value: r.Value, Valid: r.IsValid()}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.Before(out[j].Time) })
+	return out
+}
+
+// Gaps returns the timestamps at which a reading is missing between the first and last
+// present reading, stepping by expectedInterval from the first reading.
+// When expectedInterval is not positive, it is inferred from the TimeBase of the first reading
+// ("HO" = 1h, "SH" = 30m, "DM" = 10m, "MI" = 1m); Gaps returns nil if it cannot be inferred.
+// Readings need not be sorted. Timestamps are returned in UTC, in chronological order.
+func (v VariableObservation) Gaps(expectedInterval time.Duration) []time.Time {
+	if len(v.Readings) == 0 {
+		return nil
+	}
+	if expectedInterval <= 0 {
+		interval, ok := v.Readings[0].Interval()
+		if !ok {
+			return nil
+		}
+		expectedInterval = interval
+	}
+
+	present := make(map[time.Time]struct{}, len(v.Readings))
+	first, last := v.Readings[0].Data.UTC(), v.Readings[0].Data.UTC()
+	for _, r := range v.Readings {
+		t := r.Data.UTC()
+		present[t] = struct{}{}
+		if t.Before(first) {
+			first = t
+		}
+		if t.After(last) {
+			last = t
+		}
+	}
+
+	var gaps []time.Time
+	for t := first.Add(expectedInterval); t.Before(last); t = t.Add(expectedInterval) {
+		if _, ok := present[t]; !ok {
+			gaps = append(gaps, t)
+		}
+	}
+	return gaps
+}
+
+// VariableBetween returns the readings of the variable identified by code whose Data falls
+// in the half-open window [start, end). It returns nil when the station did not measure the
+// variable or no reading falls in the window.
+func (o StationObservation) VariableBetween(code int, start, end time.Time) []Reading {
+	for _, v := range o.Variables {
+		if v.Code == code {
+			return v.Between(start, end)
+		}
+	}
+	return nil
+}
+
+// SeriesFor returns the time series of the variable identified by code (see VariableObservation.Series).
+// It reports false when the station did not measure the variable.
+func (o StationObservation) SeriesFor(code int) ([]TimedValue, bool) {
+	for _, v := range o.Variables {
+		if v.Code == code {
+			return v.Series(), true
+		}
+	}
+	return nil, false
+}
+
+// FormatWith formats every reading with the number of decimals declared for its variable in vars,
+// typically the list returned by the variables metadata endpoint. The result maps each variable code
+// to its formatted readings, in the order EachReading visits them across stations. Variables missing
+// from vars use the shortest exact representation.
+func (l StationObservationList) FormatWith(vars VariableList) map[int][]string {
+	decimals := vars.DecimalsByCode()
+	out := make(map[int][]string)
+	l.EachReading(func(_ string, variableCode int, r Reading) bool {
+		precision, ok := decimals[variableCode]
+		if !ok {
+			precision = -1
+		}
+		out[variableCode] = append(out[variableCode], r.Format(precision))
+		return true
+	})
+	return out
+}
+
+// WriteCSV writes one row per reading with the columns station_code, variable_code, timestamp,
+// value, status and time_base, preceded by a header row. Timestamps are ISO-8601 in UTC.
+//
+// decimals optionally maps variable codes to the number of decimals used when formatting values
+// (see Variable.Decimals); variables missing from the map use the shortest exact representation.
+func (l StationObservationList) WriteCSV(w io.Writer, decimals map[int]int) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"station_code", "variable_code", "timestamp", "value", "status", "time_base"}); err != nil {
+		return err
+	}
+
+	var writeErr error
+	l.EachReading(func(stationCode string, variableCode int, r Reading) bool {
+		precision, ok := decimals[variableCode]
+		if !ok {
+			precision = -1
+		}
+		writeErr = cw.Write([]string{
+			stationCode,
+			strconv.Itoa(variableCode),
+			r.Data.UTC().Format(time.RFC3339),
+			r.Format(precision),
+			r.Status,
+			r.TimeBase,
+		})
+		return writeErr == nil
+	})
+	if writeErr != nil {
+		return writeErr
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// sortAndDedupReadings sorts readings by Data and drops later readings with an identical timestamp.
+func sortAndDedupReadings(readings []Reading) []Reading {
+	sort.SliceStable(readings, func(i, j int) bool {
+		return readings[i].Data.Before(readings[j].Data.Time)
+	})
+
+	out := readings[:0]
+	for i, r := range readings {
+		if i > 0 && r.Data.Equal(out[len(out)-1].Data) {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// englishReading is the English-keyed shadow of Reading used by MarshalEnglish.
+type englishReading struct {
+	Time        MeteocatTime  `json:"time"`
+	ExtremeTime *MeteocatTime `json:"extremeTime,omitempty"`
+	Value       float64       `json:"value"`
+	Status      string        `json:"status"`
+	TimeBase    string        `json:"timeBase"`
+}
+
+// englishVariableObservation is the English-keyed shadow of VariableObservation.
+type englishVariableObservation struct {
+	Code     int              `json:"code"`
+	Readings []englishReading `json:"readings"`
+}
+
+// englishStationObservation is the English-keyed shadow of StationObservation.
+type englishStationObservation struct {
+	Code      string                       `json:"code"`
+	Variables []englishVariableObservation `json:"variables"`
+}
+
+// toEnglish converts the observation into its English-keyed shadow.
+func (o StationObservation) toEnglish() englishStationObservation {
+	out := englishStationObservation{
+		Code:      o.Code,
+		Variables: make([]englishVariableObservation, 0, len(o.Variables)),
+	}
+	for _, v := range o.Variables {
+		shadow := englishVariableObservation{Code: v.Code, Readings: make([]englishReading, 0, len(v.Readings))}
+		for _, r := range v.Readings {
+			shadow.Readings = append(shadow.Readings, englishReading{
+				Time:        r.Data,
+				ExtremeTime: r.DataExtrem,
+				Value:       r.Value,
+				Status:      r.Status,
+				TimeBase:    r.TimeBase,
+			})
+		}
+		out.Variables = append(out.Variables, shadow)
+	}
+	return out
+}
+
+// MarshalEnglish encodes the observation as JSON using English keys (e.g., "code", "variables",
+// "readings", "time", "extremeTime") instead of the Catalan API keys.
+// The default encoding/json behavior is unchanged.
+func (o StationObservation) MarshalEnglish() ([]byte, error) {
+	return json.Marshal(o.toEnglish())
+}
+
+// MarshalEnglish encodes the observation list as a JSON array using English keys.
+// See StationObservation.MarshalEnglish for the key mapping.
+func (l StationObservationList) MarshalEnglish() ([]byte, error) {
+	out := make([]englishStationObservation, 0, len(l))
+	for _, o := range l {
+		out = append(out, o.toEnglish())
+	}
+	return json.Marshal(out)
+}
@@ -0,0 +1,518 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestObservations builds a single-station fixture with variables 1 and 30.
+func newTestObservations() StationObservationList {
+	return StationObservationList{
+		{
+			Code: "CC",
+			Variables: []VariableObservation{
+				{
+					Code: 1,
+					Readings: []Reading{
+						{
+							Data:       MeteocatTime{Time: time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)},
+							DataExtrem: &MeteocatTime{Time: time.Date(2020, 6, 16, 0, 5, 0, 0, time.UTC)},
+							Value:      947.3,
+							Status:     "V",
+							TimeBase:   "SH",
+						},
+					},
+				},
+				{
+					Code: 30,
+					Readings: []Reading{
+						{
+							Data:     MeteocatTime{Time: time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)},
+							Value:    0.6,
+							Status:   "V",
+							TimeBase: "SH",
+						},
+						{
+							Data:     MeteocatTime{Time: time.Date(2020, 6, 16, 0, 30, 0, 0, time.UTC)},
+							Value:    0.6,
+							Status:   "V",
+							TimeBase: "SH",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// TestStationObservationListMarshalEnglish verifies the English-keyed serialization view.
+func TestStationObservationListMarshalEnglish(t *testing.T) {
+	data, err := newTestObservations().MarshalEnglish()
+	if err != nil {
+		t.Fatalf("marshal english: %v", err)
+	}
+
+	var decoded []struct {
+		Code      string `json:"code"`
+		Variables []struct {
+			Code     int `json:"code"`
+			Readings []struct {
+				Time        string  `json:"time"`
+				ExtremeTime *string `json:"extremeTime"`
+				Value       float64 `json:"value"`
+				Status      string  `json:"status"`
+				TimeBase    string  `json:"timeBase"`
+			} `json:"readings"`
+		} `json:"variables"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal english: %v", err)
+	}
+
+	if len(decoded) != 1 || decoded[0].Code != "CC" {
+		t.Fatalf("expected station CC, got %+v", decoded)
+	}
+	if len(decoded[0].Variables) != 2 {
+		t.Fatalf("expected 2 variables, got %d", len(decoded[0].Variables))
+	}
+	first := decoded[0].Variables[0].Readings[0]
+	if first.Time != "2020-06-16T00:00:00Z" {
+		t.Errorf("expected time 2020-06-16T00:00:00Z, got %s", first.Time)
+	}
+	if first.ExtremeTime == nil || *first.ExtremeTime != "2020-06-16T00:05:00Z" {
+		t.Errorf("expected extremeTime 2020-06-16T00:05:00Z, got %v", first.ExtremeTime)
+	}
+	if first.Value != 947.3 || first.Status != "V" || first.TimeBase != "SH" {
+		t.Errorf("unexpected reading %+v", first)
+	}
+}
+
+// TestStationObservationListMerge_Disjoint verifies that readings from different days
+// are concatenated and sorted per station and variable.
+func TestStationObservationListMerge_Disjoint(t *testing.T) {
+	dayOne := newTestObservations()
+	dayTwo := StationObservationList{
+		{
+			Code: "CC",
+			Variables: []VariableObservation{
+				{
+					Code: 30,
+					Readings: []Reading{
+						{Data: MeteocatTime{Time: time.Date(2020, 6, 17, 0, 0, 0, 0, time.UTC)}, Value: 1.1, Status: "V", TimeBase: "SH"},
+					},
+				},
+			},
+		},
+		{
+			Code: "D5",
+			Variables: []VariableObservation{
+				{
+					Code: 30,
+					Readings: []Reading{
+						{Data: MeteocatTime{Time: time.Date(2020, 6, 17, 0, 0, 0, 0, time.UTC)}, Value: 2.4, Status: "V", TimeBase: "SH"},
+					},
+				},
+			},
+		},
+	}
+
+	// Merge in reverse chronological order to check sorting.
+	merged := dayTwo.Merge(dayOne)
+
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 stations, got %d", len(merged))
+	}
+	if merged[0].Code != "CC" || merged[1].Code != "D5" {
+		t.Fatalf("unexpected station order %s, %s", merged[0].Code, merged[1].Code)
+	}
+	if len(merged[0].Variables) != 2 {
+		t.Fatalf("expected 2 variables for CC, got %d", len(merged[0].Variables))
+	}
+
+	wind := merged[0].Variables[0]
+	if wind.Code != 30 || len(wind.Readings) != 3 {
+		t.Fatalf("expected 3 readings for variable 30, got %+v", wind)
+	}
+	for i := 1; i < len(wind.Readings); i++ {
+		if wind.Readings[i].Data.Before(wind.Readings[i-1].Data.Time) {
+			t.Errorf("readings not sorted at index %d", i)
+		}
+	}
+
+	if len(dayOne[0].Variables[1].Readings) != 2 {
+		t.Errorf("expected input to be left untouched, got %d readings", len(dayOne[0].Variables[1].Readings))
+	}
+}
+
+// TestStationObservationListMerge_Overlapping verifies that readings with identical
+// timestamps are deduplicated when merging overlapping days.
+func TestStationObservationListMerge_Overlapping(t *testing.T) {
+	first := newTestObservations()
+	second := newTestObservations()
+	second[0].Variables[1].Readings = append(second[0].Variables[1].Readings, Reading{
+		Data:     MeteocatTime{Time: time.Date(2020, 6, 16, 1, 0, 0, 0, time.UTC)},
+		Value:    0.8,
+		Status:   "V",
+		TimeBase: "SH",
+	})
+
+	merged := first.Merge(second)
+
+	if len(merged) != 1 {
+		t.Fatalf("expected 1 station, got %d", len(merged))
+	}
+	if got := len(merged[0].Variables[0].Readings); got != 1 {
+		t.Errorf("expected 1 reading for variable 1, got %d", got)
+	}
+	if got := len(merged[0].Variables[1].Readings); got != 3 {
+		t.Errorf("expected 3 readings for variable 30, got %d", got)
+	}
+}
+
+// TestStationObservationListEachReading verifies that every reading is visited in order.
+func TestStationObservationListEachReading(t *testing.T) {
+	count := 0
+	var codes []int
+	newTestObservations().EachReading(func(stationCode string, variableCode int, r Reading) bool {
+		if stationCode != "CC" {
+			t.Errorf("expected station CC, got %s", stationCode)
+		}
+		count++
+		codes = append(codes, variableCode)
+		return true
+	})
+
+	if count != 3 {
+		t.Errorf("expected 3 readings, got %d", count)
+	}
+	if len(codes) != 3 || codes[0] != 1 || codes[1] != 30 || codes[2] != 30 {
+		t.Errorf("unexpected variable order %v", codes)
+	}
+}
+
+// TestStationObservationListEachReading_Stop verifies that iteration stops when fn returns false.
+func TestStationObservationListEachReading_Stop(t *testing.T) {
+	count := 0
+	newTestObservations().EachReading(func(stationCode string, variableCode int, r Reading) bool {
+		count++
+		if r.Value != 947.3 {
+			t.Errorf("expected first reading value 947.3, got %f", r.Value)
+		}
+		return false
+	})
+
+	if count != 1 {
+		t.Errorf("expected iteration to stop after 1 reading, got %d", count)
+	}
+}
+
+// TestVariableObservationBetween verifies half-open window filtering of readings.
+func TestVariableObservationBetween(t *testing.T) {
+	station := newTestObservations()[0]
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, 6, 16, hour, minute, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name       string
+		start, end time.Time
+		expected   int
+	}{
+		{"first only", at(0, 0), at(0, 30), 1},
+		{"second only", at(0, 15), at(1, 0), 1},
+		{"both", at(0, 0), at(1, 0), 2},
+		{"neither", at(9, 0), at(12, 0), 0},
+		{"non-UTC bounds", at(0, 0).In(time.FixedZone("CEST", 2*60*60)), at(0, 30).In(time.FixedZone("CEST", 2*60*60)), 1},
+	}
+
+	for _, tc := range testCases {
+		readings := station.VariableBetween(30, tc.start, tc.end)
+		if len(readings) != tc.expected {
+			t.Errorf("%s: expected %d readings, got %d", tc.name, tc.expected, len(readings))
+		}
+	}
+
+	if readings := station.Variables[1].Between(at(0, 30), at(1, 0)); len(readings) != 1 || !readings[0].Data.Equal(NewMeteocatTime(at(0, 30))) {
+		t.Errorf("expected the 00:30 reading, got %+v", readings)
+	}
+	if readings := station.VariableBetween(99, at(0, 0), at(1, 0)); readings != nil {
+		t.Errorf("expected nil for unknown variable, got %+v", readings)
+	}
+}
+
+// TestStationObservationListValidateDay verifies that readings outside the requested UTC day are flagged.
+func TestStationObservationListValidateDay(t *testing.T) {
+	date := time.Date(2020, 6, 16, 12, 0, 0, 0, time.UTC)
+	observations := newTestObservations()
+	if outside := observations.ValidateDay(date); outside != nil {
+		t.Errorf("expected all readings to be valid, got %+v", outside)
+	}
+
+	readings := &observations[0].Variables[1].Readings
+	*readings = append(*readings,
+		Reading{Data: NewMeteocatTime(time.Date(2020, 6, 16, 23, 59, 0, 0, time.UTC)), Value: 0.2},
+		Reading{Data: NewMeteocatTime(time.Date(2020, 6, 17, 0, 0, 0, 0, time.UTC)), Value: 0.4},
+	)
+
+	outside := observations.ValidateDay(date.In(time.FixedZone("CEST", 2*60*60)))
+	if len(outside) != 1 || outside[0].Value != 0.4 {
+		t.Errorf("expected only the next day's midnight reading, got %+v", outside)
+	}
+	if outside := observations.ValidateDay(date.AddDate(0, 0, 1)); len(outside) != 4 {
+		t.Errorf("expected 4 readings outside the next day, got %d", len(outside))
+	}
+}
+
+// TestStationObservationListWriteCSV verifies the header and per-reading rows.
+func TestStationObservationListWriteCSV(t *testing.T) {
+	variables := VariableList{{Code: 1, Decimals: 1}, {Code: 30, Decimals: 2}}
+
+	var buf strings.Builder
+	if err := newTestObservations().WriteCSV(&buf, variables.DecimalsByCode()); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	expected := []string{
+		"station_code,variable_code,timestamp,value,status,time_base",
+		"CC,1,2020-06-16T00:00:00Z,947.3,V,SH",
+		"CC,30,2020-06-16T00:00:00Z,0.60,V,SH",
+		"CC,30,2020-06-16T00:30:00Z,0.60,V,SH",
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %d: %q", len(expected), len(lines), lines)
+	}
+	for i, want := range expected {
+		if lines[i] != want {
+			t.Errorf("line %d: expected %q, got %q", i, want, lines[i])
+		}
+	}
+}
+
+// TestStationObservationListWriteCSV_DefaultPrecision verifies formatting without a decimals map.
+func TestStationObservationListWriteCSV_DefaultPrecision(t *testing.T) {
+	var buf strings.Builder
+	if err := newTestObservations().WriteCSV(&buf, nil); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	if !strings.Contains(buf.String(), "CC,30,2020-06-16T00:30:00Z,0.6,V,SH\n") {
+		t.Errorf("expected shortest value representation, got %q", buf.String())
+	}
+}
+
+// TestVariableObservationNearestTo verifies nearest-reading selection, tie-breaking and invalid readings.
+func TestVariableObservationNearestTo(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2020, 6, 16, hour, minute, 0, 0, time.UTC)
+	}
+	reading := func(hour, minute int, value float64, status string) Reading {
+		return Reading{Data: NewMeteocatTime(at(hour, minute)), Value: value, Status: status}
+	}
+	variable := VariableObservation{Code: 32, Readings: []Reading{
+		reading(14, 30, 24.1, "V"),
+		reading(13, 30, 23.5, "V"),
+		reading(13, 55, 99, "N"),
+	}}
+
+	testCases := []struct {
+		name     string
+		target   time.Time
+		expected float64
+	}{
+		{"bracketed, closer after", at(14, 20), 24.1},
+		{"bracketed tie resolves earlier", at(14, 0), 23.5},
+		{"invalid reading ignored", at(13, 55), 23.5},
+		{"entirely after the series", at(20, 0), 24.1},
+	}
+	for _, tc := range testCases {
+		r, ok := variable.NearestTo(tc.target)
+		if !ok || r.Value != tc.expected {
+			t.Errorf("%s: expected %v, got %+v (ok=%v)", tc.name, tc.expected, r, ok)
+		}
+	}
+
+	r, _ := variable.NearestTo(at(20, 0))
+	r.Value = 0
+	if variable.Readings[0].Value != 24.1 {
+		t.Error("expected the returned reading to be a copy")
+	}
+
+	invalidOnly := VariableObservation{Readings: []Reading{reading(14, 0, 1, "N")}}
+	if _, ok := invalidOnly.NearestTo(at(14, 0)); ok {
+		t.Error("expected no reading when all are invalid")
+	}
+}
+
+// TestVariableObservationGaps verifies detection of a missing interior sample.
+func TestVariableObservationGaps(t *testing.T) {
+	at := func(minute int) MeteocatTime {
+		return MeteocatTime{Time: time.Date(2020, 6, 16, 0, minute, 0, 0, time.UTC)}
+	}
+	variable := VariableObservation{
+		Code: 32,
+		Readings: []Reading{
+			{Data: at(0), TimeBase: "SH"},
+			{Data: at(90), TimeBase: "SH"},
+			{Data: at(30), TimeBase: "SH"},
+		},
+	}
+
+	gaps := variable.Gaps(0)
+	if len(gaps) != 1 || !gaps[0].Equal(at(60).Time) {
+		t.Fatalf("expected a single gap at 01:00, got %v", gaps)
+	}
+
+	explicit := variable.Gaps(10 * time.Minute)
+	if len(explicit) != 7 {
+		t.Errorf("expected 7 gaps with a 10-minute interval, got %d", len(explicit))
+	}
+
+	if gaps := newTestObservations()[0].Variables[1].Gaps(0); gaps != nil {
+		t.Errorf("expected no gaps in a complete series, got %v", gaps)
+	}
+
+	unknown := VariableObservation{Readings: []Reading{{Data: at(0), TimeBase: "XX"}, {Data: at(60), TimeBase: "XX"}}}
+	if gaps := unknown.Gaps(0); gaps != nil {
+		t.Errorf("expected nil for an unknown time base, got %v", gaps)
+	}
+}
+
+// TestReadingStatusEnum verifies the mapping of raw status values to ReadingStatus.
+func TestReadingStatusEnum(t *testing.T) {
+	testCases := []struct {
+		raw      string
+		expected ReadingStatus
+		valid    bool
+	}{
+		{"", StatusPending, false},
+		{"T", StatusValidationStarted, false},
+		{"V", StatusValid, true},
+		{"N", StatusInvalid, false},
+		{"X", ReadingStatus("X"), false},
+	}
+
+	for _, tc := range testCases {
+		r := Reading{Status: tc.raw}
+		if got := r.StatusEnum(); got != tc.expected {
+			t.Errorf("status %q: expected %q, got %q", tc.raw, tc.expected, got)
+		}
+		if got := r.IsValid(); got != tc.valid {
+			t.Errorf("status %q: expected IsValid %t, got %t", tc.raw, tc.valid, got)
+		}
+	}
+}
+
+// TestTimeBaseInterval verifies the cadence of each known time base and rejection of unknown codes.
+func TestTimeBaseInterval(t *testing.T) {
+	testCases := []struct {
+		timeBase TimeBase
+		expected time.Duration
+		ok       bool
+	}{
+		{TimeBaseHourly, time.Hour, true},
+		{TimeBaseSemiHourly, 30 * time.Minute, true},
+		{TimeBaseTenMinutes, 10 * time.Minute, true},
+		{TimeBaseMinute, time.Minute, true},
+		{"XX", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tc := range testCases {
+		interval, ok := tc.timeBase.Interval()
+		if interval != tc.expected || ok != tc.ok {
+			t.Errorf("time base %q: expected (%v, %t), got (%v, %t)", tc.timeBase, tc.expected, tc.ok, interval, ok)
+		}
+
+		interval, ok = Reading{TimeBase: string(tc.timeBase)}.Interval()
+		if interval != tc.expected || ok != tc.ok {
+			t.Errorf("reading with time base %q: expected (%v, %t), got (%v, %t)", tc.timeBase, tc.expected, tc.ok, interval, ok)
+		}
+	}
+}
+
+// TestStationObservationListByStationAndSingle verifies single- and multi-element lists.
+func TestStationObservationListByStationAndSingle(t *testing.T) {
+	single := StationObservationList{{Code: "CC"}}
+	multi := StationObservationList{{Code: "CC"}, {Code: "D5"}}
+
+	observation, ok := single.Single()
+	if !ok || observation.Code != "CC" {
+		t.Errorf("expected the sole observation, got %+v (ok=%t)", observation, ok)
+	}
+	if byStation := single.ByStation(); len(byStation) != 1 || byStation["CC"].Code != "CC" {
+		t.Errorf("unexpected index %+v", byStation)
+	}
+
+	if observation, ok := multi.Single(); ok {
+		t.Errorf("expected no single observation for two stations, got %+v", observation)
+	}
+	if observation, ok := (StationObservationList{}).Single(); ok {
+		t.Errorf("expected no single observation for an empty list, got %+v", observation)
+	}
+	byStation := multi.ByStation()
+	if len(byStation) != 2 || byStation["D5"].Code != "D5" {
+		t.Errorf("unexpected index %+v", byStation)
+	}
+}
+
+// TestReadingFormat verifies rounding to the declared decimals and the shortest representation.
+func TestReadingFormat(t *testing.T) {
+	r := Reading{Value: 0.6000000000000001}
+	testCases := []struct {
+		decimals int
+		expected string
+	}{
+		{1, "0.6"},
+		{0, "1"},
+		{3, "0.600"},
+		{-1, "0.6000000000000001"},
+	}
+	for _, tc := range testCases {
+		if got := r.Format(tc.decimals); got != tc.expected {
+			t.Errorf("decimals %d: expected %s, got %s", tc.decimals, tc.expected, got)
+		}
+	}
+}
+
+// TestStationObservationListFormatWith verifies per-variable formatting from the metadata decimals.
+func TestStationObservationListFormatWith(t *testing.T) {
+	variables := VariableList{{Code: 1, Decimals: 1}, {Code: 30, Decimals: 1}}
+	formatted := newTestObservations().FormatWith(variables)
+
+	if got := strings.Join(formatted[1], ","); got != "947.3" {
+		t.Errorf("variable 1: expected 947.3, got %s", got)
+	}
+	if got := strings.Join(formatted[30], ","); got != "0.6,0.6" {
+		t.Errorf("variable 30: expected 0.6,0.6, got %s", got)
+	}
+
+	if got := strings.Join(newTestObservations().FormatWith(nil)[1], ","); got != "947.3" {
+		t.Errorf("expected the shortest representation without metadata, got %s", got)
+	}
+}
+
+// TestStationObservationSeriesFor verifies time ordering, validity flags and unknown variables.
+func TestStationObservationSeriesFor(t *testing.T) {
+	observation := newTestObservations()[0]
+	readings := observation.Variables[1].Readings
+	readings[0], readings[1] = readings[1], readings[0]
+	readings[0].Status = "T"
+
+	series, ok := observation.SeriesFor(30)
+	if !ok || len(series) != 2 {
+		t.Fatalf("expected 2 values, got %+v (ok=%v)", series, ok)
+	}
+	first, second := series[0], series[1]
+	if !first.Time.Equal(time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)) || first.Value != 0.6 || !first.Valid {
+		t.Errorf("unexpected first value %+v", first)
+	}
+	if !second.Time.Equal(time.Date(2020, 6, 16, 0, 30, 0, 0, time.UTC)) || second.Valid {
+		t.Errorf("expected the pending 00:30 reading last and not valid, got %+v", second)
+	}
+
+	if _, ok := observation.SeriesFor(99); ok {
+		t.Error("expected ok=false for an unmeasured variable")
+	}
+}
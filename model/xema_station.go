@@ -1,5 +1,11 @@
 package model
 
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
 // StationStatus defines the operational status filter values supported by the API.
 // During its lifetime, a station can have different operational states.
 type StationStatus string
@@ -56,6 +62,80 @@ type Station struct {
 // StationList represents a collection of XEMA stations returned by the METEOCAT API.
 type StationList []Station
 
+// NormalizeStationCode returns code in the API's canonical form: surrounding whitespace removed and
+// uppercased (e.g., " cc " becomes "CC"). Station codes are case-sensitive in request paths.
+func NormalizeStationCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ResolveCounty looks up the station's county code in regions, typically the authoritative list returned
+// by the regions metadata endpoint, and returns the canonical region. It reports false when no region
+// with that code exists. The returned region is a copy; modifying it does not affect regions.
+func (s Station) ResolveCounty(regions RegionList) (*Region, bool) {
+	return regions.ByCode(s.County.Code)
+}
+
+// AboveAltitude returns the stations located at or above meters.
+// Altitude is the station elevation in meters, as returned by the API.
+func (l StationList) AboveAltitude(meters float64) StationList {
+	return l.filter(func(s Station) bool { return s.Altitude >= meters })
+}
+
+// AltitudeBetween returns the stations whose altitude lies in the inclusive range [min, max] meters.
+// Altitude is the station elevation in meters, as returned by the API.
+func (l StationList) AltitudeBetween(min, max float64) StationList {
+	return l.filter(func(s Station) bool { return s.Altitude >= min && s.Altitude <= max })
+}
+
+// filter returns the stations for which keep reports true, preserving order.
+func (l StationList) filter(keep func(Station) bool) StationList {
+	out := make(StationList, 0, len(l))
+	for _, s := range l {
+		if keep(s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// SortByCode returns a copy of the list sorted by station code. The sort is stable and
+// the original list is not modified.
+func (l StationList) SortByCode() StationList {
+	return l.sortedBy(func(a, b Station) int { return strings.Compare(a.Code, b.Code) })
+}
+
+// SortByName returns a copy of the list sorted by station name, comparing names byte-wise.
+// The sort is stable, so stations sharing a name keep their relative order, and the original
+// list is not modified.
+func (l StationList) SortByName() StationList {
+	return l.sortedBy(func(a, b Station) int { return strings.Compare(a.Name, b.Name) })
+}
+
+// sortedBy returns a stably sorted copy of the list.
+func (l StationList) sortedBy(cmp func(a, b Station) int) StationList {
+	out := slices.Clone(l)
+	slices.SortStableFunc(out, cmp)
+	return out
+}
+
+// Duplicates reports the station codes that appear more than once in the list, mapping each of them
+// to the indices where it occurs, in order. Codes are compared exactly. A list without repeated
+// codes yields an empty map.
+func (l StationList) Duplicates() map[string][]int {
+	indices := make(map[string][]int, len(l))
+	for i, s := range l {
+		indices[s.Code] = append(indices[s.Code], i)
+	}
+
+	duplicates := make(map[string][]int)
+	for code, idx := range indices {
+		if len(idx) > 1 {
+			duplicates[code] = idx
+		}
+	}
+	return duplicates
+}
+
 // StationProvince represents the province reference associated with a station.
 type StationProvince struct {
 	// Code is the numeric identifier of the province
@@ -65,6 +145,20 @@ type StationProvince struct {
 	Name string `json:"nom"`
 }
 
+// UnmarshalJSON decodes a province, accepting the code as a JSON number or a numeric string.
+func (p *StationProvince) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Code IntOrString `json:"codi"`
+		Name string      `json:"nom"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	p.Code = int(raw.Code)
+	p.Name = raw.Name
+	return nil
+}
+
 // StationNetwork represents the network reference associated with a station.
 type StationNetwork struct {
 	// Code is the numeric identifier of the network
@@ -85,3 +179,16 @@ type StationState struct {
 	// EndDate is the end date/time of the state in RFC3339 format, or nil if ongoing
 	EndDate *MeteocatTime `json:"dataFi"`
 }
+
+// VariableStation lists a station that measures a given variable, together with the periods during
+// which the variable was measured there.
+type VariableStation struct {
+	// Code is the unique identifier of the station (e.g., "CC")
+	Code string `json:"codi"`
+
+	// States lists the measurement states of the variable at the station over time
+	States []StationState `json:"estats"`
+}
+
+// VariableStationList represents the stations measuring a variable, as returned by the METEOCAT API.
+type VariableStationList []VariableStation
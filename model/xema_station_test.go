@@ -0,0 +1,143 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newTestStations builds stations at increasing elevations.
+func newTestStations() StationList {
+	return StationList{
+		{Code: "D5", Name: "Barcelona - Observatori Fabra", Altitude: 411},
+		{Code: "CC", Name: "Orís", Altitude: 626},
+		{Code: "Z1", Name: "Port Ainé", Altitude: 2316},
+		{Code: "X4", Name: "Barcelona - el Raval", Altitude: 33},
+	}
+}
+
+// stationCodes joins the codes of l for compact assertions.
+func stationCodes(l StationList) string {
+	codes := make([]string, len(l))
+	for i, s := range l {
+		codes[i] = s.Code
+	}
+	return strings.Join(codes, ",")
+}
+
+// TestStationListAboveAltitude verifies inclusive lower-bound filtering.
+func TestStationListAboveAltitude(t *testing.T) {
+	stations := newTestStations()
+
+	testCases := []struct {
+		meters   float64
+		expected string
+	}{
+		{626, "CC,Z1"},
+		{627, "Z1"},
+		{0, "D5,CC,Z1,X4"},
+		{3000, ""},
+	}
+	for _, tc := range testCases {
+		if got := stationCodes(stations.AboveAltitude(tc.meters)); got != tc.expected {
+			t.Errorf("AboveAltitude(%v): expected %q, got %q", tc.meters, tc.expected, got)
+		}
+	}
+}
+
+// TestStationListAltitudeBetween verifies inclusive range filtering at both boundaries.
+func TestStationListAltitudeBetween(t *testing.T) {
+	stations := newTestStations()
+
+	testCases := []struct {
+		min, max float64
+		expected string
+	}{
+		{411, 626, "D5,CC"},
+		{411.5, 625.5, ""},
+		{33, 33, "X4"},
+		{1000, 500, ""},
+	}
+	for _, tc := range testCases {
+		if got := stationCodes(stations.AltitudeBetween(tc.min, tc.max)); got != tc.expected {
+			t.Errorf("AltitudeBetween(%v, %v): expected %q, got %q", tc.min, tc.max, tc.expected, got)
+		}
+	}
+}
+
+// TestStationResolveCounty verifies lookup of the station county in the regions list.
+func TestStationResolveCounty(t *testing.T) {
+	regions := RegionList{{Code: 13, Name: "Barcelonès"}, {Code: 24, Name: "Osona"}}
+
+	station := Station{Code: "CC", County: Region{Code: 24}}
+	region, ok := station.ResolveCounty(regions)
+	if !ok || region.Name != "Osona" {
+		t.Fatalf("expected Osona, got %+v (ok=%v)", region, ok)
+	}
+	region.Name = "changed"
+	if regions[1].Name != "Osona" {
+		t.Error("expected the regions list to be left unmodified")
+	}
+
+	unknown := Station{Code: "ZZ", County: Region{Code: 99, Name: "Unknown"}}
+	if region, ok := unknown.ResolveCounty(regions); ok || region != nil {
+		t.Errorf("expected no region for unknown county, got %+v", region)
+	}
+}
+
+// TestStationListSort verifies code and name ordering and that the original list is untouched.
+func TestStationListSort(t *testing.T) {
+	stations := newTestStations()
+
+	if got := stationCodes(stations.SortByCode()); got != "CC,D5,X4,Z1" {
+		t.Errorf("SortByCode: expected CC,D5,X4,Z1, got %s", got)
+	}
+	if got := stationCodes(stations.SortByName()); got != "D5,X4,CC,Z1" {
+		t.Errorf("SortByName: expected D5,X4,CC,Z1, got %s", got)
+	}
+	if got := stationCodes(stations); got != "D5,CC,Z1,X4" {
+		t.Errorf("expected original order to be kept, got %s", got)
+	}
+
+	duplicates := StationList{{Code: "B", Name: "Same"}, {Code: "A", Name: "Same"}}
+	if got := stationCodes(duplicates.SortByName()); got != "B,A" {
+		t.Errorf("expected stable order for equal names, got %s", got)
+	}
+}
+
+// TestStationListDuplicates verifies that only repeated codes are reported, with their indices.
+func TestStationListDuplicates(t *testing.T) {
+	if got := newTestStations().Duplicates(); len(got) != 0 {
+		t.Errorf("expected no duplicates, got %v", got)
+	}
+
+	stations := append(newTestStations(), Station{Code: "CC", Name: "Orís (duplicate)"}, Station{Code: "Z1"}, Station{Code: "CC"})
+	got := stations.Duplicates()
+	expected := map[string][]int{"CC": {1, 4, 6}, "Z1": {2, 5}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+// TestStationProvinceUnmarshalJSON verifies that numeric and string codes decode alike.
+func TestStationProvinceUnmarshalJSON(t *testing.T) {
+	for _, data := range []string{`{"codi":24,"nom":"Lleida"}`, `{"codi":"24","nom":"Lleida"}`} {
+		var province StationProvince
+		if err := json.Unmarshal([]byte(data), &province); err != nil {
+			t.Fatalf("%s: unexpected error: %v", data, err)
+		}
+		if province.Code != 24 || province.Name != "Lleida" {
+			t.Errorf("%s: unexpected province %+v", data, province)
+		}
+	}
+}
+
+// TestNormalizeStationCode verifies trimming and uppercasing.
+func TestNormalizeStationCode(t *testing.T) {
+	for _, code := range []string{"CC", "cc", " CC ", "\tcC\n"} {
+		if got := NormalizeStationCode(code); got != "CC" {
+			t.Errorf("%q: expected CC, got %q", code, got)
+		}
+	}
+}
@@ -0,0 +1,465 @@
+package meteocat
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// ClientOption configures optional behavior of a Client at construction time.
+// Options are applied in order by NewClient; an option returning an error aborts construction.
+type ClientOption func(*Client) error
+
+// ResponseCaptureFunc receives the raw response body of every request that produced one.
+// The path is the API resource (e.g., "/xema/v1/estacions/metadades"), status is the HTTP status code
+// and body holds the bytes after charset normalization, before any unmarshaling takes place.
+type ResponseCaptureFunc func(path string, status int, body []byte)
+
+// WithResponseCapture registers a callback that receives the raw (normalized) response body before
+// it is unmarshaled. The callback fires for both successful and error responses, which makes schema
+// drift and unexpected error payloads observable.
+//
+// Response bodies may contain sensitive data; the API key is never part of the body, but the callback
+// should only be enabled in development or with appropriate redaction in place.
+// The body slice must not be modified or retained after the callback returns.
+func WithResponseCapture(fn ResponseCaptureFunc) ClientOption {
+	return func(c *Client) error {
+		c.responseCapture = fn
+		return nil
+	}
+}
+
+// ResponseSizeFunc receives the size in bytes of a response body for the given API resource.
+type ResponseSizeFunc func(path string, bytes int)
+
+// WithResponseSizeMetrics registers a callback that receives the decoded (decompressed) body size of
+// every response, for capacity planning. It fires right after the body is read, before any JSON parsing,
+// for both successful and error responses. When a body exceeds the response size limit the callback still
+// fires, reporting the limit as the truncated size, so oversized responses remain visible.
+func WithResponseSizeMetrics(fn ResponseSizeFunc) ClientOption {
+	return func(c *Client) error {
+		c.responseSize = fn
+		return nil
+	}
+}
+
+// ResultValidatorFunc checks a decoded result before it is returned. The resource is the API path
+// (e.g., "/referencia/v1/municipis") and out is the pointer the response was unmarshaled into
+// (e.g., *model.MunicipalityList). A non-nil error rejects the result.
+type ResultValidatorFunc func(resource string, out any) error
+
+// WithResultValidator registers a callback that enforces invariants on every successfully decoded
+// result (e.g., "the municipality list has more than 500 entries"), to catch truncated or empty
+// datasets from a degraded API. A rejected result is returned as an APIError that wraps the
+// validator's error and matches ErrInvalidResult with errors.Is. Rejected results are not retried.
+// The callback is not invoked for streamed responses, which are never fully decoded into one value.
+func WithResultValidator(fn ResultValidatorFunc) ClientOption {
+	return func(c *Client) error {
+		c.resultValidator = fn
+		return nil
+	}
+}
+
+// BeforeRequestFunc inspects or rewrites an outgoing request just before it is sent.
+// A non-nil error aborts the request.
+type BeforeRequestFunc func(req *http.Request) error
+
+// WithBeforeRequest registers a callback that runs on every outgoing request after the standard
+// headers are set and immediately before it is sent, e.g. to add signing headers or rewrite the host
+// for a CDN. An error aborts the request with an APIError wrapping it; such failures are not retried.
+// The request already carries the API key header: reading, replacing or removing it is the
+// callback's responsibility, and the key must not be logged.
+func WithBeforeRequest(fn BeforeRequestFunc) ClientOption {
+	return func(c *Client) error {
+		c.beforeRequest = fn
+		return nil
+	}
+}
+
+// runBeforeRequest applies the WithBeforeRequest callback, if any, to req.
+func (c *Client) runBeforeRequest(req *http.Request) *model.APIError {
+	if c.beforeRequest == nil {
+		return nil
+	}
+	if err := c.beforeRequest(req); err != nil {
+		return &model.APIError{Message: fmt.Sprintf("before request: %v", err), Err: err}
+	}
+	return nil
+}
+
+// WithAPIKeyHeader sends the API key in headerName instead of the default "x-api-key" header.
+// When scheme is non-empty the header value is "<scheme> <key>", so
+// WithAPIKeyHeader("Authorization", "Bearer") produces "Authorization: Bearer <key>".
+// This is intended for proxies and sandbox environments that expect a different header.
+func WithAPIKeyHeader(headerName string, scheme string) ClientOption {
+	return func(c *Client) error {
+		headerName = strings.TrimSpace(headerName)
+		if headerName == "" {
+			return fmt.Errorf("api key header name is required")
+		}
+		if strings.ContainsAny(headerName, " \t\r\n:") {
+			return fmt.Errorf("invalid api key header name %q", headerName)
+		}
+		c.apiKeyHeader = headerName
+		c.apiKeyScheme = strings.TrimSpace(scheme)
+		return nil
+	}
+}
+
+// WithDefaultRequestTimeout applies a timeout of d to every request whose context has no deadline.
+// Contexts that already carry a deadline are left untouched, so a caller-provided deadline always wins,
+// even when it is longer than d. The timeout covers the whole request, including reading the body.
+func WithDefaultRequestTimeout(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("default request timeout must be positive, got %v", d)
+		}
+		c.requestTimeout = d
+		return nil
+	}
+}
+
+// WithUserAgent replaces the User-Agent header sent with every request.
+// It is mutually exclusive with WithUserAgentSuffix: whichever option is applied last wins.
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) error {
+		ua = strings.TrimSpace(ua)
+		if ua == "" {
+			return fmt.Errorf("user agent is required")
+		}
+		c.userAgent = ua
+		return nil
+	}
+}
+
+// WithUserAgentSuffix appends suffix to the default User-Agent, producing "meteocat-go/<Version> <suffix>",
+// so applications embedding this library keep the library attribution.
+// It is mutually exclusive with WithUserAgent: whichever option is applied last wins.
+func WithUserAgentSuffix(suffix string) ClientOption {
+	return func(c *Client) error {
+		suffix = strings.TrimSpace(suffix)
+		if suffix == "" {
+			return fmt.Errorf("user agent suffix is required")
+		}
+		c.userAgent = userAgent + " " + suffix
+		return nil
+	}
+}
+
+// WithClientName sets a friendly name identifying the application using the client (e.g., "tenant-a-dashboard").
+// The name is appended to the User-Agent header after any WithUserAgent or WithUserAgentSuffix value,
+// regardless of option order, and is included in the client's String output for logging.
+// The name must not be empty or contain control characters.
+func WithClientName(name string) ClientOption {
+	return func(c *Client) error {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return fmt.Errorf("client name is required")
+		}
+		if strings.IndexFunc(name, unicode.IsControl) >= 0 {
+			return fmt.Errorf("invalid client name %q: contains control characters", name)
+		}
+		c.clientName = name
+		return nil
+	}
+}
+
+// Bounds of the basic API key format check enabled by WithStrictKeyValidation.
+const (
+	minStrictAPIKeyLength = 20
+	maxStrictAPIKeyLength = 128
+)
+
+// WithStrictKeyValidation makes NewClient reject API keys that fail a basic format check:
+// between 20 and 128 characters, made only of ASCII letters and digits (as issued by the
+// METEOCAT developer portal). This catches truncated keys, pasted whitespace or quotes and
+// placeholder values before any request is made, instead of surfacing as a 401/403 later.
+// Validation is off by default so that future key formats keep working.
+func WithStrictKeyValidation() ClientOption {
+	return func(c *Client) error {
+		c.strictKeyValidation = true
+		return nil
+	}
+}
+
+// validateAPIKeyFormat reports whether key passes the check described in WithStrictKeyValidation.
+// The error never includes the key itself.
+func validateAPIKeyFormat(key string) error {
+	if len(key) < minStrictAPIKeyLength || len(key) > maxStrictAPIKeyLength {
+		return fmt.Errorf("invalid api key format: expected %d to %d characters, got %d", minStrictAPIKeyLength, maxStrictAPIKeyLength, len(key))
+	}
+	for i := 0; i < len(key); i++ {
+		b := key[i]
+		if !('a' <= b && b <= 'z' || 'A' <= b && b <= 'Z' || '0' <= b && b <= '9') {
+			return fmt.Errorf("invalid api key format: unexpected character at position %d", i+1)
+		}
+	}
+	return nil
+}
+
+// WithLanguage sends lang (e.g., "es" or "en, ca;q=0.8") in the Accept-Language header of every request,
+// so names such as regions or symbols may be returned in another language. The effect depends on
+// the METEOCAT API honoring the header; without support the data stays in Catalan. Either way, the
+// JSON field names, and so the model types, are unchanged.
+func WithLanguage(lang string) ClientOption {
+	return func(c *Client) error {
+		lang = strings.TrimSpace(lang)
+		if lang == "" {
+			return fmt.Errorf("language is required")
+		}
+		if strings.IndexFunc(lang, unicode.IsControl) >= 0 {
+			return fmt.Errorf("invalid language %q: contains control characters", lang)
+		}
+		c.language = lang
+		return nil
+	}
+}
+
+// WithCodeNormalization controls whether the client normalizes station codes with model.NormalizeStationCode
+// (trim and uppercase) before building request paths, so "cc" and " CC " both request station "CC".
+// Normalization is enabled by default; pass false to send codes exactly as given.
+// Municipality codes are not affected; see WithMunicipalityCodePadding.
+// The functions of the endpoint package always use codes as given.
+func WithCodeNormalization(enabled bool) ClientOption {
+	return func(c *Client) error {
+		c.rawStationCodes = !enabled
+		return nil
+	}
+}
+
+// stationCode returns code as it must be sent to the API, according to WithCodeNormalization.
+func (c *Client) stationCode(code string) string {
+	if c.rawStationCodes {
+		return code
+	}
+	return model.NormalizeStationCode(code)
+}
+
+// WithMunicipalityCodePadding makes the client normalize municipality codes with
+// model.NormalizeMunicipalityCode (trim, and zero-pad numeric codes to 6 digits) before building
+// request paths, so "25019" and " 025019 " both request municipality "025019". Unlike station code
+// normalization it is off by default, as padding changes the code rather than only its formatting.
+// It applies to every forecast method taking municipality codes; MunicipalHourlyForecasts keys its
+// results by the normalized codes. The functions of the endpoint package always use codes as given.
+func WithMunicipalityCodePadding() ClientOption {
+	return func(c *Client) error {
+		c.normMunicipalities = true
+		return nil
+	}
+}
+
+// municipalityCode returns code as it must be sent to the API, according to WithMunicipalityCodePadding.
+func (c *Client) municipalityCode(code string) string {
+	if c.normMunicipalities {
+		return model.NormalizeMunicipalityCode(code)
+	}
+	return code
+}
+
+// WithBaseURL sends requests to rawURL instead of the production METEOCAT API,
+// e.g. a local mock server or a recording proxy. The URL must be absolute with an http or https scheme.
+func WithBaseURL(rawURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(strings.TrimSpace(rawURL))
+		if err != nil {
+			return fmt.Errorf("invalid base url %q: %w", rawURL, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid base url %q: must be an absolute http or https url", rawURL)
+		}
+		c.baseURL = strings.TrimRight(u.String(), "/")
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables TLS certificate verification so the client can talk to a
+// sandbox or mock server using a self-signed certificate.
+//
+// SECURITY: with verification disabled, any server can impersonate the target host and read
+// the API key and responses. This option must only be used in tests and local development.
+// As a guard, NewClient refuses to apply it unless WithBaseURL points to a host other than
+// the production API (api.meteo.cat). The option order does not matter.
+//
+// The provided http.Client and its transport are not modified; the client works on a copy.
+// The transport must be nil (http.DefaultTransport) or an *http.Transport.
+func WithInsecureSkipVerify() ClientOption {
+	return func(c *Client) error {
+		c.insecureSkipVerify = true
+		c.transportChanged = true
+		return nil
+	}
+}
+
+// checkInsecureSkipVerify fails when WithInsecureSkipVerify is combined with a base URL targeting
+// the production API host. It runs on every apply, so a clone cannot point an insecure client at production.
+func (c *Client) checkInsecureSkipVerify() error {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return fmt.Errorf("insecure skip verify: invalid base url %q: %w", c.baseURL, err)
+	}
+	defaultURL, _ := url.Parse(baseURL)
+	if strings.EqualFold(strings.TrimSuffix(u.Hostname(), "."), defaultURL.Hostname()) {
+		return fmt.Errorf("insecure skip verify is not allowed against %s; use WithBaseURL to target a sandbox", defaultURL.Hostname())
+	}
+	return nil
+}
+
+// applyInsecureSkipVerify replaces the client's transport with a copy that skips TLS verification.
+func (c *Client) applyInsecureSkipVerify() error {
+	transport, err := c.cloneTransport("insecure skip verify")
+	if err != nil {
+		return err
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = true
+
+	c.setTransport(transport)
+	return nil
+}
+
+// WithTransport sends requests through rt instead of the http.Client's own transport, keeping the
+// client's timeout and other settings. The provided http.Client is not modified; the client works on a copy.
+// WithProxy and WithInsecureSkipVerify are applied on top of rt, so they require it to be an *http.Transport.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *Client) error {
+		if rt == nil {
+			return fmt.Errorf("transport is required")
+		}
+		c.transport = rt
+		c.transportChanged = true
+		return nil
+	}
+}
+
+// WithProxy routes every request through the proxy at proxyURL (e.g., "http://proxy.corp:3128").
+// Supported schemes are http, https and socks5; credentials may be given in the URL userinfo.
+// The proxy replaces any proxy configured on the transport, including the environment-based default.
+//
+// The proxy is installed on a copy of the transport, after WithTransport regardless of option order,
+// so the http.Client timeout and the supplied transport's other settings are kept. NewClient fails
+// when the transport in use is not an *http.Transport.
+func WithProxy(proxyURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(strings.TrimSpace(proxyURL))
+		if err != nil {
+			return fmt.Errorf("invalid proxy url %q: %w", proxyURL, err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return fmt.Errorf("invalid proxy url %q: scheme must be http, https or socks5", proxyURL)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("invalid proxy url %q: missing host", proxyURL)
+		}
+		c.proxyURL = u
+		c.transportChanged = true
+		return nil
+	}
+}
+
+// connectionPool holds the idle connection settings configured with WithConnectionPool.
+type connectionPool struct {
+	maxIdle        int
+	maxIdlePerHost int
+	idleTimeout    time.Duration
+}
+
+// WithConnectionPool tunes connection reuse for workloads issuing many concurrent requests, such as
+// MunicipalHourlyForecasts: maxIdle caps idle connections across all hosts, maxIdlePerHost caps them
+// for the METEOCAT host (net/http defaults to 2) and idleTimeout closes connections idle for longer.
+// Zero keeps the net/http meaning of each setting (no limit, the default of 2, no timeout).
+//
+// The settings are applied to a dedicated copy of the transport, before WithProxy and
+// WithInsecureSkipVerify, so the http.Client timeout is kept. NewClient fails when the transport
+// in use is not an *http.Transport.
+func WithConnectionPool(maxIdle, maxIdlePerHost int, idleTimeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		if maxIdle < 0 || maxIdlePerHost < 0 {
+			return fmt.Errorf("connection pool sizes must not be negative, got %d and %d", maxIdle, maxIdlePerHost)
+		}
+		if idleTimeout < 0 {
+			return fmt.Errorf("connection pool idle timeout must not be negative, got %v", idleTimeout)
+		}
+		c.pool = &connectionPool{maxIdle: maxIdle, maxIdlePerHost: maxIdlePerHost, idleTimeout: idleTimeout}
+		c.transportChanged = true
+		return nil
+	}
+}
+
+// applyTransport installs the WithTransport, WithConnectionPool, WithProxy, WithCompression and
+// WithInsecureSkipVerify settings on a copy of the caller's http.Client. It starts over from the
+// caller's http.Client on every call, so a Clone can also turn settings back off. apply only calls it
+// when an option changed those settings; otherwise the transport, and its connections, is kept.
+func (c *Client) applyTransport() error {
+	c.httpClient = c.baseHTTPClient
+	if c.transport != nil {
+		c.setTransport(c.transport)
+	}
+
+	if c.pool != nil {
+		transport, err := c.cloneTransport("connection pool")
+		if err != nil {
+			return err
+		}
+		transport.MaxIdleConns = c.pool.maxIdle
+		transport.MaxIdleConnsPerHost = c.pool.maxIdlePerHost
+		transport.IdleConnTimeout = c.pool.idleTimeout
+		c.setTransport(transport)
+	}
+
+	if c.proxyURL != nil {
+		transport, err := c.cloneTransport("proxy")
+		if err != nil {
+			return err
+		}
+		transport.Proxy = http.ProxyURL(c.proxyURL)
+		c.setTransport(transport)
+	}
+
+	if c.compressionDisabled {
+		switch c.httpClient.Transport.(type) {
+		case nil, *http.Transport:
+			transport, err := c.cloneTransport("disabling compression")
+			if err != nil {
+				return err
+			}
+			transport.DisableCompression = true
+			c.setTransport(transport)
+		}
+	}
+
+	if c.insecureSkipVerify {
+		return c.applyInsecureSkipVerify()
+	}
+	return nil
+}
+
+// cloneTransport returns a copy of the client's transport, or of http.DefaultTransport when none is set,
+// so feature can adjust it without affecting the caller's transport.
+func (c *Client) cloneTransport(feature string) (*http.Transport, error) {
+	switch t := c.httpClient.Transport.(type) {
+	case nil:
+		return http.DefaultTransport.(*http.Transport).Clone(), nil
+	case *http.Transport:
+		return t.Clone(), nil
+	default:
+		return nil, fmt.Errorf("%s requires an *http.Transport, got %T", feature, t)
+	}
+}
+
+// setTransport replaces the transport on a copy of the client's http.Client.
+func (c *Client) setTransport(transport http.RoundTripper) {
+	httpClient := *c.httpClient
+	httpClient.Transport = transport
+	c.httpClient = &httpClient
+}
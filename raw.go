@@ -0,0 +1,43 @@
+package meteocat
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/luisfrmoro/meteocat/endpoint"
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// WithRawResponseAccess enables the ...Raw client methods (e.g., ObservationsRaw), which also return
+// the response body after charset normalization, for debugging and auditing. Only those calls keep
+// a copy of the body, doubling the memory they hold; other methods are unaffected. Off by default.
+func WithRawResponseAccess() ClientOption {
+	return func(c *Client) error {
+		c.rawResponses = true
+		return nil
+	}
+}
+
+// rawDo returns a DoFunc for a single-request endpoint call that records the raw body of the
+// response into the returned message. It fails when raw response access is disabled.
+func (c *Client) rawDo() (endpoint.DoFunc, *json.RawMessage, *model.APIError) {
+	if !c.rawResponses {
+		return nil, nil, &model.APIError{Message: "raw response access is disabled; create the client with WithRawResponseAccess"}
+	}
+
+	var raw json.RawMessage
+	do := func(ctx context.Context, method, resource string, out any) *model.APIError {
+		ctx = context.WithValue(ctx, rawCaptureKey, true)
+		meta, apiErr := c.doWithMeta(ctx, method, resource, out)
+		raw = meta.Raw
+		return apiErr
+	}
+	return do, &raw, nil
+}
+
+// captureRaw reports whether the body of the response to a request made with ctx must be kept in
+// Meta.Raw: raw response access is enabled and the request comes from rawDo.
+func (c *Client) captureRaw(ctx context.Context) bool {
+	capture, _ := ctx.Value(rawCaptureKey).(bool)
+	return c.rawResponses && capture
+}
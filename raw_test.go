@@ -0,0 +1,80 @@
+package meteocat
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// TestObservationsRaw verifies that the raw body is the normalized UTF-8 form of the response.
+func TestObservationsRaw(t *testing.T) {
+	fixture := `[{"codi":"CC","variables":[{"codi":30,"lectures":[{"data":"2020-06-16T00:00Z","valor":0.6,"estat":"V","baseHoraria":"SH"}]}]}]`
+	date := time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC)
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", "\xef\xbb\xbf"+fixture), nil
+	}, WithRawResponseAccess())
+
+	list, raw, apiErr := client.ObservationsRaw(context.Background(), "CC", date)
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if string(raw) != fixture {
+		t.Errorf("expected raw body %s, got %s", fixture, raw)
+	}
+	if len(list) != 1 || list[0].Variables[0].Readings[0].Value != 0.6 {
+		t.Errorf("unexpected observations %+v", list)
+	}
+
+	client = newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		t.Error("expected no request without raw response access")
+		return nil, nil
+	})
+	if _, _, apiErr := client.ObservationsRaw(context.Background(), "CC", date); apiErr == nil {
+		t.Error("expected an error without raw response access")
+	}
+}
+
+// TestObservationsRaw_Latin1 verifies that legacy charsets are transcoded before the raw body is kept.
+func TestObservationsRaw_Latin1(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json; charset=iso-8859-1", "[{\"codi\":\"CC\",\"nom\":\"Or\xeds\"}]"), nil
+	}, WithRawResponseAccess())
+
+	_, raw, apiErr := client.ObservationsRaw(context.Background(), "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC))
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if expected := `[{"codi":"CC","nom":"Orís"}]`; string(raw) != expected {
+		t.Errorf("expected %s, got %s", expected, raw)
+	}
+}
+
+// TestRawResponseAccess_OnlyRawCalls verifies that only ...Raw calls keep a copy of the body.
+func TestRawResponseAccess_OnlyRawCalls(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithRawResponseAccess())
+
+	var regions model.RegionList
+	meta, apiErr := client.doWithMeta(context.Background(), http.MethodGet, "/referencia/v1/comarques", &regions)
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if meta.Raw != nil {
+		t.Errorf("expected no raw body outside ...Raw calls, got %s", meta.Raw)
+	}
+
+	do, raw, apiErr := client.rawDo()
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if apiErr := do(context.Background(), http.MethodGet, "/referencia/v1/comarques", &regions); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if string(*raw) != `[]` {
+		t.Errorf("expected the raw body from rawDo, got %s", *raw)
+	}
+}
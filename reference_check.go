@@ -0,0 +1,37 @@
+package meteocat
+
+import "github.com/luisfrmoro/meteocat/model"
+
+// WithNonEmptyReferenceCheck makes Regions, Municipalities, Symbols and Variables fail when the API
+// returns an empty list. These catalogs are never empty in normal operation, so an empty response
+// usually signals an API problem that callers would otherwise process as "no data". Like a
+// WithResultValidator rejection, the error has the response status code, matches both
+// ErrEmptyReference and ErrInvalidResult with errors.Is and is reported as a failed call to
+// WithRequestMetrics. Disabled by default.
+func WithNonEmptyReferenceCheck() ClientOption {
+	return func(c *Client) error {
+		c.nonEmptyReference = true
+		return nil
+	}
+}
+
+// emptyReferenceList reports whether out holds an empty list decoded from one of the reference
+// resources checked by WithNonEmptyReferenceCheck.
+func emptyReferenceList(resource string, out any) bool {
+	switch resource {
+	case "/referencia/v1/comarques":
+		list, ok := out.(*model.RegionList)
+		return ok && len(*list) == 0
+	case "/referencia/v1/municipis":
+		list, ok := out.(*model.MunicipalityList)
+		return ok && len(*list) == 0
+	case "/referencia/v1/simbols":
+		list, ok := out.(*model.SymbolList)
+		return ok && len(*list) == 0
+	case "/xema/v1/variables/mesurades/metadades":
+		list, ok := out.(*model.VariableList)
+		return ok && len(*list) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,74 @@
+package meteocat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// TestWithNonEmptyReferenceCheck verifies that empty reference lists fail only when the check is enabled.
+func TestWithNonEmptyReferenceCheck(t *testing.T) {
+	body := `[]`
+	fn := func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", body), nil
+	}
+	var metrics []RequestMetrics
+	client := newTestClient(t, fn, WithNonEmptyReferenceCheck(), WithRequestMetrics(func(m RequestMetrics) {
+		metrics = append(metrics, m)
+	}))
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"Regions": func() error {
+			_, apiErr := client.Regions(ctx)
+			return errorOrNil(apiErr)
+		},
+		"Municipalities": func() error {
+			_, apiErr := client.Municipalities(ctx)
+			return errorOrNil(apiErr)
+		},
+		"Symbols": func() error {
+			_, apiErr := client.Symbols(ctx)
+			return errorOrNil(apiErr)
+		},
+		"Variables": func() error {
+			_, apiErr := client.Variables(ctx)
+			return errorOrNil(apiErr)
+		},
+	}
+	for name, call := range calls {
+		err := call()
+		if !errors.Is(err, ErrEmptyReference) || !errors.Is(err, ErrInvalidResult) {
+			t.Errorf("%s: expected ErrEmptyReference and ErrInvalidResult for an empty list, got %v", name, err)
+		}
+	}
+	if len(metrics) != len(calls) {
+		t.Fatalf("expected %d recorded calls, got %d", len(calls), len(metrics))
+	}
+	for _, m := range metrics {
+		if m.Err == nil || !errors.Is(m.Err, ErrEmptyReference) {
+			t.Errorf("%s: expected the rejected call to be recorded as failed, got %+v", m.Endpoint, m)
+		}
+	}
+
+	body = `[{"codi": 1, "nom": "Alt Camp"}]`
+	if regions, apiErr := client.Regions(ctx); apiErr != nil || len(regions) != 1 {
+		t.Errorf("expected a populated list to pass, got %v, %v", regions, apiErr)
+	}
+
+	body = `[]`
+	if _, apiErr := newTestClient(t, fn).Regions(ctx); apiErr != nil {
+		t.Errorf("expected empty lists to be accepted by default, got %v", apiErr)
+	}
+}
+
+// errorOrNil converts a possibly nil *APIError into an error without creating a non-nil interface.
+func errorOrNil(apiErr *model.APIError) error {
+	if apiErr == nil {
+		return nil
+	}
+	return apiErr
+}
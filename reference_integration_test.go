@@ -81,7 +81,7 @@ func hasMunicipalityCoordinates(mun *model.Municipality) bool {
 	if mun.Coordinates == nil {
 		return false
 	}
-	return mun.Coordinates.Latitude != 0 || mun.Coordinates.Longitude != 0
+	return !mun.Coordinates.IsZero()
 }
 
 func logMunicipalitySample(t *testing.T, i int, mun *model.Municipality) {
@@ -231,12 +231,6 @@ func TestIntegrationRegionsAndMunicipalitiesConsistency(t *testing.T) {
 		t.Fatalf("municipalities request: %v", apiErr)
 	}
 
-	// Create a map of region codes for fast lookup
-	regionMap := make(map[int]*model.Region)
-	for i := range regions {
-		regionMap[regions[i].Code] = &regions[i]
-	}
-
 	t.Logf("Checking %d municipalities against %d regions", len(municipalities), len(regions))
 
 	// Validate that all municipality region references exist in regions data
@@ -247,7 +241,7 @@ func TestIntegrationRegionsAndMunicipalitiesConsistency(t *testing.T) {
 			continue
 		}
 
-		if _, exists := regionMap[mun.Region.Code]; !exists {
+		if _, exists := regions.ByCode(mun.Region.Code); !exists {
 			t.Logf("municipality[%d] (%s): region Code %d not found in regions reference data", i, mun.Name, mun.Region.Code)
 			invalidRegionReferences++
 		}
@@ -277,16 +271,9 @@ func TestIntegrationDataStability(t *testing.T) {
 		t.Fatalf("second regions request: %v", apiErr)
 	}
 
-	// Verify count matches
-	if len(regions1) != len(regions2) {
-		t.Fatalf("regions count changed between calls: %d vs %d", len(regions1), len(regions2))
-	}
-
-	// Verify first few entries match
-	for i := 0; i < len(regions1) && i < 5; i++ {
-		if regions1[i].Code != regions2[i].Code || regions1[i].Name != regions2[i].Name {
-			t.Fatalf("region[%d] changed between calls: %+v vs %+v", i, regions1[i], regions2[i])
-		}
+	// Verify no region was added, removed or changed
+	if added, removed, changed := regions1.Diff(regions2); len(added)+len(removed)+len(changed) > 0 {
+		t.Fatalf("regions changed between calls: added %+v, removed %+v, changed %+v", added, removed, changed)
 	}
 
 	// Fetch municipalities twice
@@ -300,9 +287,9 @@ func TestIntegrationDataStability(t *testing.T) {
 		t.Fatalf("second municipalities request: %v", apiErr)
 	}
 
-	// Verify count matches
-	if len(municipalities1) != len(municipalities2) {
-		t.Fatalf("municipalities count changed between calls: %d vs %d", len(municipalities1), len(municipalities2))
+	// Verify no municipality was added, removed or changed
+	if added, removed, changed := municipalities1.Diff(municipalities2); len(added)+len(removed)+len(changed) > 0 {
+		t.Fatalf("municipalities changed between calls: added %d, removed %d, changed %d", len(added), len(removed), len(changed))
 	}
 
 	// Fetch symbols twice
@@ -0,0 +1,138 @@
+package meteocat
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// defaultRetryMaxDelay caps each backoff delay unless changed with WithRetryMaxDelay.
+const defaultRetryMaxDelay = 30 * time.Second
+
+// defaultRetryableStatusCodes are the gateway errors retried when retries are enabled.
+var defaultRetryableStatusCodes = []int{
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryPolicy controls how failed requests are retried.
+// The zero number of retries (maxAttempts of 1) disables retrying entirely.
+type retryPolicy struct {
+	maxAttempts int
+	baseDelay   time.Duration
+	maxDelay    time.Duration
+	statusCodes map[int]struct{}
+
+	// randInt64N returns a uniform random number in [0, n); it is replaceable for deterministic tests.
+	randInt64N func(n int64) int64
+}
+
+// defaultRetryPolicy returns a policy that performs a single attempt and, once retries are
+// enabled with WithRetry, retries transport errors and the default gateway status codes.
+func defaultRetryPolicy() retryPolicy {
+	codes := make(map[int]struct{}, len(defaultRetryableStatusCodes))
+	for _, code := range defaultRetryableStatusCodes {
+		codes[code] = struct{}{}
+	}
+	return retryPolicy{
+		maxAttempts: 1,
+		maxDelay:    defaultRetryMaxDelay,
+		statusCodes: codes,
+		randInt64N:  rand.Int64N,
+	}
+}
+
+// retryableStatus reports whether a response with the given status code should be retried.
+func (p retryPolicy) retryableStatus(status int) bool {
+	_, ok := p.statusCodes[status]
+	return ok
+}
+
+// backoff returns the delay before the retry following the given zero-based attempt, using full jitter:
+// a uniformly random duration between 0 and min(maxDelay, baseDelay*2^attempt), inclusive.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	ceiling := p.maxDelay
+	if attempt < 62 {
+		if exp := p.baseDelay << attempt; exp>>attempt == p.baseDelay && exp < ceiling {
+			ceiling = exp
+		}
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(p.randInt64N(int64(ceiling) + 1))
+}
+
+// wait sleeps for the backoff of attempt, returning false if ctx is done first.
+// All attempts share the deadline of ctx, so when the remaining time is shorter than the delay
+// it returns false right away instead of sleeping past the deadline.
+func (p retryPolicy) wait(ctx context.Context, attempt int) bool {
+	delay := p.backoff(attempt)
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// WithRetry retries failed requests up to maxAttempts attempts in total, with exponential backoff and
+// full jitter: before retry n (starting at 0) the client waits a random duration between 0 and
+// min(maxDelay, baseDelay*2^n), where maxDelay defaults to 30s (see WithRetryMaxDelay).
+// Transport errors and the retryable status codes (by default 502, 503 and 504,
+// see WithRetryableStatusCodes) are retried; other failures are returned immediately.
+// Waiting between attempts respects ctx cancellation, and all attempts share the deadline of ctx:
+// when the remaining time is shorter than the next delay, the last error is returned without waiting.
+func WithRetry(maxAttempts int, baseDelay time.Duration) ClientOption {
+	return func(c *Client) error {
+		if maxAttempts < 1 {
+			return fmt.Errorf("retry max attempts must be at least 1, got %d", maxAttempts)
+		}
+		if baseDelay < 0 {
+			return fmt.Errorf("retry base delay must not be negative, got %v", baseDelay)
+		}
+		c.retry.maxAttempts = maxAttempts
+		c.retry.baseDelay = baseDelay
+		return nil
+	}
+}
+
+// WithRetryableStatusCodes replaces the default set of HTTP status codes (502, 503, 504)
+// that trigger a retry. Calling it with no codes disables status-based retries, so only
+// transport errors are retried. Codes must be valid HTTP status codes (100-599).
+// It has no effect unless retries are enabled with WithRetry.
+func WithRetryableStatusCodes(codes ...int) ClientOption {
+	return func(c *Client) error {
+		set := make(map[int]struct{}, len(codes))
+		for _, code := range codes {
+			if code < 100 || code > 599 {
+				return fmt.Errorf("invalid retryable status code %d", code)
+			}
+			set[code] = struct{}{}
+		}
+		c.retry.statusCodes = set
+		return nil
+	}
+}
+
+// WithRetryMaxDelay caps each backoff delay between retries at d (30s by default).
+// It has no effect unless retries are enabled with WithRetry.
+func WithRetryMaxDelay(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		if d <= 0 {
+			return fmt.Errorf("retry max delay must be positive, got %v", d)
+		}
+		c.retry.maxDelay = d
+		return nil
+	}
+}
@@ -0,0 +1,200 @@
+package meteocat
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// statusSequence returns a transport replying with the given statuses in order,
+// repeating the last one, and counting the calls.
+func statusSequence(calls *int, statuses ...int) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		status := statuses[len(statuses)-1]
+		if *calls < len(statuses) {
+			status = statuses[*calls]
+		}
+		*calls++
+		if status == http.StatusOK {
+			return newTestResponse(req, status, "application/json", `[]`), nil
+		}
+		return newTestResponse(req, status, "application/json", `{"message":"unavailable"}`), nil
+	}
+}
+
+// TestWithRetry_DefaultStatusCodes verifies that gateway errors are retried until success.
+func TestWithRetry_DefaultStatusCodes(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, statusSequence(&calls, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK), WithRetry(3, 0))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+}
+
+// TestWithRetry_TransportError verifies that transport errors are retried.
+func TestWithRetry_TransportError(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("connection reset")
+		}
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithRetry(2, 0))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+}
+
+// TestWithRetryableStatusCodes_Custom verifies that a custom set retries 408 but not 503.
+func TestWithRetryableStatusCodes_Custom(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, statusSequence(&calls, http.StatusRequestTimeout, http.StatusOK),
+		WithRetry(3, 0), WithRetryableStatusCodes(http.StatusRequestTimeout, http.StatusTooEarly))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+
+	calls = 0
+	client = newTestClient(t, statusSequence(&calls, http.StatusServiceUnavailable),
+		WithRetry(3, 0), WithRetryableStatusCodes(http.StatusRequestTimeout))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil || apiErr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 error, got %v", apiErr)
+	}
+	if calls != 1 {
+		t.Errorf("expected 503 not to be retried, got %d attempts", calls)
+	}
+}
+
+// TestWithRetryableStatusCodes_Disabled verifies that an empty set disables status-based retries
+// while transport errors are still retried.
+func TestWithRetryableStatusCodes_Disabled(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, statusSequence(&calls, http.StatusBadGateway), WithRetry(3, 0), WithRetryableStatusCodes())
+
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil || apiErr.Code != http.StatusBadGateway {
+		t.Fatalf("expected 502 error, got %v", apiErr)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single attempt, got %d", calls)
+	}
+
+	calls = 0
+	client = newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		calls++
+		return nil, errors.New("connection reset")
+	}, WithRetry(3, 0), WithRetryableStatusCodes())
+
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil {
+		t.Fatal("expected transport error, got nil")
+	}
+	if calls != 3 {
+		t.Errorf("expected transport errors to be retried 3 times, got %d", calls)
+	}
+}
+
+// TestWithRetryableStatusCodes_Invalid verifies that out-of-range codes are rejected.
+func TestWithRetryableStatusCodes_Invalid(t *testing.T) {
+	for _, code := range []int{0, 99, 600} {
+		if _, err := NewClient(testAPIKey, nil, WithRetryableStatusCodes(code)); err == nil {
+			t.Errorf("expected error for status code %d, got nil", code)
+		}
+	}
+}
+
+// TestRetry_DisabledByDefault verifies that requests are not retried without WithRetry.
+func TestRetry_DisabledByDefault(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, statusSequence(&calls, http.StatusServiceUnavailable, http.StatusOK))
+
+	if _, apiErr := client.Regions(context.Background()); apiErr == nil {
+		t.Fatal("expected 503 error, got nil")
+	}
+	if calls != 1 {
+		t.Errorf("expected a single attempt, got %d", calls)
+	}
+}
+
+// TestRetryPolicy_Backoff verifies that jittered delays stay within the cap and grow on average.
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := defaultRetryPolicy()
+	policy.baseDelay = 100 * time.Millisecond
+	policy.maxDelay = 2 * time.Second
+	policy.randInt64N = rand.New(rand.NewPCG(1, 2)).Int64N
+
+	const samples = 200
+	var previousMean time.Duration
+	for attempt := 0; attempt < 8; attempt++ {
+		ceiling := min(policy.maxDelay, policy.baseDelay<<attempt)
+
+		var total time.Duration
+		for i := 0; i < samples; i++ {
+			d := policy.backoff(attempt)
+			if d < 0 || d > ceiling {
+				t.Fatalf("attempt %d: delay %v outside [0, %v]", attempt, d, ceiling)
+			}
+			total += d
+		}
+
+		mean := total / samples
+		if ceiling < policy.maxDelay && mean <= previousMean {
+			t.Errorf("attempt %d: expected mean delay to increase, got %v after %v", attempt, mean, previousMean)
+		}
+		previousMean = mean
+	}
+
+	if d := policy.backoff(200); d > policy.maxDelay {
+		t.Errorf("expected large attempts to be capped, got %v", d)
+	}
+}
+
+// TestWithRetryMaxDelay_Invalid verifies that non-positive caps are rejected.
+func TestWithRetryMaxDelay_Invalid(t *testing.T) {
+	for _, d := range []time.Duration{0, -time.Second} {
+		if _, err := NewClient(testAPIKey, nil, WithRetryMaxDelay(d)); err == nil {
+			t.Errorf("expected error for max delay %v", d)
+		}
+	}
+}
+
+// TestWithRetry_DeadlineBudget verifies that retries stop once the next backoff would outlast the
+// context deadline, returning the last error promptly instead of sleeping past it.
+func TestWithRetry_DeadlineBudget(t *testing.T) {
+	calls := 0
+	client := newTestClient(t, statusSequence(&calls, http.StatusServiceUnavailable), WithRetry(5, 50*time.Millisecond))
+	client.retry.randInt64N = func(n int64) int64 { return n - 1 }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, apiErr := client.Regions(ctx)
+	elapsed := time.Since(start)
+
+	if apiErr == nil || apiErr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected the last 503 error, got %v", apiErr)
+	}
+	// Delays of 50ms and 100ms: the second retry no longer fits in the remaining budget.
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+	if elapsed >= 120*time.Millisecond {
+		t.Errorf("expected to return before the deadline, took %v", elapsed)
+	}
+}
@@ -0,0 +1,41 @@
+package meteocat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// WithMaxConcurrentRequests limits the number of HTTP requests the client has in flight at once to n.
+// Additional requests block until a slot frees up or their context ends. The limit applies per attempt,
+// so requests waiting between retries do not hold a slot. It is coarser than rate limiting and protects
+// against connection exhaustion when many goroutines share a Client. A value of 0 means unlimited.
+func WithMaxConcurrentRequests(n int) ClientOption {
+	return func(c *Client) error {
+		if n < 0 {
+			return fmt.Errorf("max concurrent requests must not be negative, got %d", n)
+		}
+		if n == 0 {
+			c.slots = nil
+			return nil
+		}
+		c.slots = make(chan struct{}, n)
+		return nil
+	}
+}
+
+// acquireSlot blocks until a request slot is available or ctx is done.
+// The returned release function must be called once the request has completed.
+func (c *Client) acquireSlot(ctx context.Context) (func(), *model.APIError) {
+	if c.slots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.slots <- struct{}{}:
+		return func() { <-c.slots }, nil
+	case <-ctx.Done():
+		return nil, &model.APIError{Message: fmt.Sprintf("waiting for request slot: %v", ctx.Err()), Err: ctx.Err()}
+	}
+}
@@ -0,0 +1,86 @@
+package meteocat
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestWithMaxConcurrentRequests verifies that no more than n requests run at the same time.
+func TestWithMaxConcurrentRequests(t *testing.T) {
+	const limit = 2
+	var inFlight, peak atomic.Int32
+
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		current := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if current <= p || peak.CompareAndSwap(p, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithMaxConcurrentRequests(limit))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, apiErr := client.Regions(context.Background()); apiErr != nil {
+				t.Errorf("unexpected error: %v", apiErr)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > limit {
+		t.Errorf("expected at most %d concurrent requests, got %d", limit, got)
+	}
+}
+
+// TestWithMaxConcurrentRequests_ContextCancel verifies that a blocked request honors its context.
+func TestWithMaxConcurrentRequests_ContextCancel(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		close(started)
+		<-release
+		return newTestResponse(req, http.StatusOK, "application/json", `[]`), nil
+	}, WithMaxConcurrentRequests(1))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		client.Regions(context.Background())
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, apiErr := client.Regions(ctx); apiErr == nil {
+		t.Error("expected error while waiting for a slot")
+	}
+
+	close(release)
+	<-done
+}
+
+// TestWithMaxConcurrentRequests_Invalid verifies that negative limits are rejected and zero means unlimited.
+func TestWithMaxConcurrentRequests_Invalid(t *testing.T) {
+	if _, err := NewClient(testAPIKey, nil, WithMaxConcurrentRequests(-1)); err == nil {
+		t.Error("expected error for negative limit")
+	}
+	client, err := NewClient(testAPIKey, nil, WithMaxConcurrentRequests(0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.slots != nil {
+		t.Error("expected no limit for zero")
+	}
+}
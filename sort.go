@@ -0,0 +1,25 @@
+package meteocat
+
+import (
+	"cmp"
+	"slices"
+)
+
+// WithSortedResults makes the reference and station list methods (Regions, Municipalities, Stations
+// and Variables) return their results sorted by code, so repeated calls yield a deterministic order
+// regardless of the order the API sends. The sort is stable.
+func WithSortedResults() ClientOption {
+	return func(c *Client) error {
+		c.sortResults = true
+		return nil
+	}
+}
+
+// sortByCode stably sorts a freshly fetched list in place by the given code when
+// WithSortedResults is enabled. The list must not be shared with the caller yet.
+func sortByCode[S ~[]E, E any, K cmp.Ordered](c *Client, list S, code func(E) K) S {
+	if c.sortResults {
+		slices.SortStableFunc(list, func(a, b E) int { return cmp.Compare(code(a), code(b)) })
+	}
+	return list
+}
@@ -0,0 +1,41 @@
+package meteocat
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+// TestWithSortedResults verifies that list methods sort by code only when the option is enabled.
+func TestWithSortedResults(t *testing.T) {
+	fn := func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json",
+			`[{"codi":"Z1","nom":"Port Ainé"},{"codi":"CC","nom":"Orís"},{"codi":"D5","nom":"Barcelona"}]`), nil
+	}
+
+	testCases := []struct {
+		opts     []ClientOption
+		expected string
+	}{
+		{nil, "Z1,CC,D5"},
+		{[]ClientOption{WithSortedResults()}, "CC,D5,Z1"},
+	}
+
+	for _, tc := range testCases {
+		client := newTestClient(t, fn, tc.opts...)
+		stations, apiErr := client.Stations(context.Background())
+		if apiErr != nil {
+			t.Fatalf("unexpected error: %v", apiErr)
+		}
+		got := ""
+		for i, s := range stations {
+			if i > 0 {
+				got += ","
+			}
+			got += s.Code
+		}
+		if got != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package meteocat
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/luisfrmoro/meteocat/endpoint"
+	"github.com/luisfrmoro/meteocat/model"
+)
+
+// errBodyTooLarge is returned by limitedBodyReader once the body exceeds the response size limit.
+var errBodyTooLarge = errors.New("response body too large")
+
+// ctxReader aborts reads once ctx is done, so decoding stops mid-body on cancellation
+// even when the underlying transport does not interrupt the body itself.
+type ctxReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *ctxReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
+
+// limitedBodyReader counts the bytes read and fails with err, or errBodyTooLarge when err is nil,
+// past limit bytes.
+type limitedBodyReader struct {
+	r     io.Reader
+	limit int64
+	n     int64
+	err   error
+}
+
+func (r *limitedBodyReader) Read(p []byte) (int, error) {
+	if r.n > r.limit {
+		return 0, r.tooLarge()
+	}
+	if remaining := r.limit - r.n + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if r.n > r.limit {
+		return n, r.tooLarge()
+	}
+	return n, err
+}
+
+func (r *limitedBodyReader) tooLarge() error {
+	if r.err != nil {
+		return r.err
+	}
+	return errBodyTooLarge
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// doStream performs a single request and lets decode consume the successful JSON body incrementally.
+// The body is read through a context-aware, size-limited reader, so cancellation aborts decoding
+// mid-body and the response size limits are still enforced. A leading UTF-8 byte-order mark is skipped;
+// bodies declaring a non-UTF-8 charset, or any body with WithStrictUTF8, are buffered and normalized
+// first. Error responses are handled as in do.
+//
+// Streaming requests are never retried, since decode may already have consumed part of the data,
+// and the response capture callback is not invoked because the body is never fully buffered.
+func (c *Client) doStream(ctx context.Context, method, resource string, decode func(*json.Decoder) error) *model.APIError {
+	start := time.Now()
+	apiErr := withRequest(c.stream(ctx, method, resource, decode), method, resource)
+	c.recordRequest(method, resource, start, http.StatusOK, apiErr)
+	return apiErr
+}
+
+// stream implements doStream without annotating errors with the request.
+func (c *Client) stream(ctx context.Context, method, resource string, decode func(*json.Decoder) error) *model.APIError {
+	if c.requestTimeout > 0 {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+			defer cancel()
+		}
+	}
+
+	release, apiErr := c.acquireSlot(ctx)
+	if apiErr != nil {
+		return apiErr
+	}
+	defer release()
+
+	url := c.baseURL + "/" + strings.TrimLeft(resource, "/")
+	req, apiErr := c.prepareRequest(ctx, method, url)
+	if apiErr != nil {
+		return apiErr
+	}
+	if apiErr := c.runBeforeRequest(req); apiErr != nil {
+		return apiErr
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return &model.APIError{Message: fmt.Sprintf("request to METEOCAT API: %v", err), Err: err}
+	}
+	defer func() {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	contentType := resp.Header.Get(contentTypeHeader)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBytes, apiErr := c.readAndNormalizeJSON(resp, resource)
+		if apiErr != nil {
+			return apiErr
+		}
+		return c.handleErrorResponse(resp, respBytes)
+	}
+	// An empty result has nothing to stream, as with the buffered endpoints.
+	if resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if !isJSONContent(contentType) {
+		return &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("unexpected content-type %q", contentType)}
+	}
+
+	var body io.Reader
+	if isUTF8Content(contentType) && !c.strictUTF8 {
+		decoded, err := c.decodedBody(resp)
+		if err != nil {
+			if apiErr := c.bodyTooLargeError(resp, resource, err); apiErr != nil {
+				return apiErr
+			}
+			return &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("decode response: %v", err)}
+		}
+		br := bufio.NewReader(decoded)
+		if prefix, _ := br.Peek(len(utf8BOM)); bytes.Equal(prefix, utf8BOM) {
+			br.Discard(len(utf8BOM))
+		}
+		body = br
+	} else {
+		respBytes, apiErr := c.readAndNormalizeJSON(resp, resource)
+		if apiErr != nil {
+			return apiErr
+		}
+		body = bytes.NewReader(respBytes)
+	}
+
+	counted := &countingReader{r: &ctxReader{ctx: ctx, r: body}}
+	err = decode(json.NewDecoder(counted))
+	if apiErr := c.bodyTooLargeError(resp, resource, err); apiErr != nil {
+		return apiErr
+	}
+	if c.responseSize != nil {
+		c.responseSize(resource, int(counted.n))
+	}
+
+	switch {
+	case err == nil:
+		return nil
+	case ctx.Err() != nil:
+		return &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("read response: %v", ctx.Err()), Err: ctx.Err()}
+	default:
+		return &model.APIError{Code: resp.StatusCode, Message: fmt.Sprintf("decode response stream: %v", err), Err: err}
+	}
+}
+
+// isUTF8Content reports whether contentType declares UTF-8 or no charset at all.
+func isUTF8Content(contentType string) bool {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return true
+	}
+	switch strings.ToLower(strings.TrimSpace(params["charset"])) {
+	case "", "utf-8", "utf8":
+		return true
+	default:
+		return false
+	}
+}
+
+// ObservationsStream fetches all observations recorded at a station for a specific day like Observations,
+// but decodes the response incrementally and calls fn for each station observation as soon as it is parsed,
+// instead of buffering the whole body first. This keeps memory bounded for very large days and lets
+// cancellation of ctx abort the download mid-parse. The response size limit still applies.
+//
+// Returning an error from fn stops decoding; the error is available through errors.Is/As on the returned
+// APIError. Streaming requests are not retried, since fn may already have received part of the data.
+//
+// Parameters:
+//   - ctx: context for cancellation and timeouts
+//   - stationCode: the unique identifier of the station (e.g., "CC")
+//   - date: the specific date for which observations are requested
+//   - fn: callback invoked for every decoded station observation
+//
+// Returns:
+//   - *APIError: error if the request fails, data cannot be parsed, ctx is cancelled or fn returns an error
+//
+// Example:
+//
+//	client, _ := meteocat.NewClient("your-api-key", nil)
+//	date := time.Date(2020, time.June, 16, 0, 0, 0, 0, time.UTC)
+//	err := client.ObservationsStream(context.Background(), "CC", date, func(obs meteocat.StationObservation) error {
+//		fmt.Printf("Station %s: %d variables\n", obs.Code, len(obs.Variables))
+//		return nil
+//	})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (c *Client) ObservationsStream(ctx context.Context, stationCode string, date time.Time, fn func(StationObservation) error) *model.APIError {
+	return endpoint.ObservationsStream(ctx, c.doStream, c.stationCode(stationCode), date, fn)
+}
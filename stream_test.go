@@ -0,0 +1,176 @@
+package meteocat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// slowReader yields its data in small chunks with a delay before each read.
+type slowReader struct {
+	data  []byte
+	chunk int
+	delay time.Duration
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	time.Sleep(r.delay)
+	n := min(len(p), r.chunk, len(r.data))
+	copy(p, r.data[:n])
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// stationsJSON builds an observations array with n stations.
+func stationsJSON(n int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = fmt.Sprintf(`{"codi":"S%d","variables":[{"codi":32,"lectures":[{"data":"2020-06-16T00:00Z","valor":18.2,"estat":"V","baseHoraria":"SH"}]}]}`, i)
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+// TestObservationsStream verifies that every station is passed to the callback in order.
+func TestObservationsStream(t *testing.T) {
+	var path string
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+		return newTestResponse(req, http.StatusOK, "application/json", stationsJSON(3)), nil
+	})
+
+	var codes []string
+	apiErr := client.ObservationsStream(context.Background(), "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(obs StationObservation) error {
+		codes = append(codes, obs.Code)
+		return nil
+	})
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if path != "/xema/v1/estacions/mesurades/CC/2020/06/16" {
+		t.Errorf("unexpected path %s", path)
+	}
+	if strings.Join(codes, ",") != "S0,S1,S2" {
+		t.Errorf("unexpected stations %v", codes)
+	}
+}
+
+// TestObservationsStream_Cancel verifies that cancelling the context aborts decoding mid-body.
+func TestObservationsStream_Cancel(t *testing.T) {
+	const stations = 50
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		resp := newTestResponse(req, http.StatusOK, "application/json", "")
+		resp.Body = io.NopCloser(&slowReader{data: []byte(stationsJSON(stations)), chunk: 64, delay: time.Millisecond})
+		return resp, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := 0
+	apiErr := client.ObservationsStream(ctx, "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(obs StationObservation) error {
+		received++
+		cancel()
+		return nil
+	})
+	if apiErr == nil {
+		t.Fatal("expected error after cancellation")
+	}
+	if !errors.Is(apiErr, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", apiErr)
+	}
+	if received == 0 || received >= stations {
+		t.Errorf("expected decoding to stop early, received %d of %d stations", received, stations)
+	}
+}
+
+// TestObservationsStream_TooLarge verifies that the response size limit is enforced while streaming.
+func TestObservationsStream_TooLarge(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", stationsJSON(20)), nil
+	})
+	client.maxResponseBody = 256
+
+	apiErr := client.ObservationsStream(context.Background(), "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(StationObservation) error {
+		return nil
+	})
+	if apiErr == nil || !strings.Contains(apiErr.Message, "too large") {
+		t.Fatalf("expected size limit error, got %v", apiErr)
+	}
+}
+
+// TestObservationsStream_CallbackError verifies that a callback error stops decoding and is wrapped.
+func TestObservationsStream_CallbackError(t *testing.T) {
+	errStop := errors.New("stop")
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", stationsJSON(3)), nil
+	})
+
+	calls := 0
+	apiErr := client.ObservationsStream(context.Background(), "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(StationObservation) error {
+		calls++
+		return errStop
+	})
+	if !errors.Is(apiErr, errStop) {
+		t.Fatalf("expected wrapped callback error, got %v", apiErr)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 callback, got %d", calls)
+	}
+}
+
+// TestObservationsStream_APIError verifies that error responses are reported like in non-streaming calls.
+func TestObservationsStream_APIError(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusNotFound, "application/json", `{"message":"Station not found"}`), nil
+	})
+
+	apiErr := client.ObservationsStream(context.Background(), "ZZ", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(StationObservation) error {
+		t.Error("callback must not be called for error responses")
+		return nil
+	})
+	if apiErr == nil || apiErr.Code != http.StatusNotFound || apiErr.Message != "Station not found" {
+		t.Fatalf("unexpected error %v", apiErr)
+	}
+}
+
+// TestObservationsStream_BOM verifies that a UTF-8 byte-order mark is skipped while streaming.
+func TestObservationsStream_BOM(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusOK, "application/json", "\xEF\xBB\xBF"+stationsJSON(2)), nil
+	})
+
+	received := 0
+	apiErr := client.ObservationsStream(context.Background(), "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(StationObservation) error {
+		received++
+		return nil
+	})
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+	if received != 2 {
+		t.Errorf("expected 2 stations, got %d", received)
+	}
+}
+
+// TestObservationsStream_NoContent verifies that an empty 204 response streams nothing and is not an error.
+func TestObservationsStream_NoContent(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) (*http.Response, error) {
+		return newTestResponse(req, http.StatusNoContent, "", ""), nil
+	})
+
+	apiErr := client.ObservationsStream(context.Background(), "CC", time.Date(2020, 6, 16, 0, 0, 0, 0, time.UTC), func(StationObservation) error {
+		t.Error("callback must not be called for an empty response")
+		return nil
+	})
+	if apiErr != nil {
+		t.Fatalf("unexpected error: %v", apiErr)
+	}
+}
@@ -44,7 +44,7 @@ func validateStationFields(t *testing.T, i int, station *model.Station) {
 	if strings.TrimSpace(station.Name) == "" {
 		t.Fatalf("station %d (Code=%s): expected Name to be set", i, station.Code)
 	}
-	if station.Coordinates.Latitude == 0 && station.Coordinates.Longitude == 0 {
+	if station.Coordinates.IsZero() {
 		t.Fatalf("station %d (Code=%s): expected Coordinates to be set", i, station.Code)
 	}
 	if strings.TrimSpace(station.Municipality.Code) == "" {